@@ -1,19 +1,23 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"log"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/aws/aws-lambda-go/lambda"
-	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
+	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 
-	"hello-go/internal"
-	"hello-go/internal/crawlers"
+	"hello-go/internal/httpcache"
+	"hello-go/internal/images"
+	"hello-go/internal/job"
+	"hello-go/internal/scheduler"
+	"hello-go/internal/snapshot"
+	"hello-go/internal/storage"
 )
 
 // getS3BucketName은 환경변수에서 S3 버킷 이름을 가져옵니다.
@@ -33,53 +37,61 @@ func getFilterDate() string {
 	return filterDate
 }
 
-// uploadToS3는 HTML 파일을 S3에 업로드합니다.
-func uploadToS3(htmlContent string) {
+// getSchedulerLimits는 환경변수에서 외부 요청 동시성 제한을 가져옵니다. 설정하지 않으면 기본값을 사용합니다.
+func getSchedulerLimits() scheduler.Limits {
+	maxInFlight, _ := strconv.Atoi(os.Getenv("MAX_IN_FLIGHT"))
+	perHost, _ := strconv.Atoi(os.Getenv("MAX_PER_HOST"))
+	return scheduler.Limits{MaxInFlight: maxInFlight, PerHost: perHost}
+}
+
+// getRetention은 환경변수에서 스냅샷 보관 정책을 가져옵니다.
+// SNAPSHOT_KEEP을 설정하지 않으면 기본값을, SNAPSHOT_MAX_AGE(예: 720h)를 설정하지 않으면 기간 제한 없이 사용합니다.
+func getRetention() snapshot.Retention {
+	retention := snapshot.Retention{Keep: snapshot.DefaultKeep}
+	if keep := os.Getenv("SNAPSHOT_KEEP"); keep != "" {
+		n, err := strconv.Atoi(keep)
+		if err != nil {
+			log.Fatalf("SNAPSHOT_KEEP 파싱 실패: %v", err)
+		}
+		retention.Keep = n
+	}
+	if maxAge := os.Getenv("SNAPSHOT_MAX_AGE"); maxAge != "" {
+		d, err := time.ParseDuration(maxAge)
+		if err != nil {
+			log.Fatalf("SNAPSHOT_MAX_AGE 파싱 실패: %v", err)
+		}
+		retention.MaxAge = d
+	}
+	return retention
+}
+
+func main() {
 	// AWS 설정 로드
 	cfg, err := config.LoadDefaultConfig(context.TODO())
 	if err != nil {
-		log.Printf("AWS 설정 로드 실패: %v", err)
+		log.Fatalf("AWS 설정 로드 실패: %v", err)
 	}
 
-	// S3 클라이언트 생성
-	s3Client := s3.NewFromConfig(cfg)
+	scheduler.Configure(getSchedulerLimits())
 
-	// HTML 내용을 바이트로 변환
-	htmlBytes := []byte(htmlContent)
-
-	// S3 버킷 이름 가져오기
-	bucketName := getS3BucketName()
+	store := storage.NewS3Storage(s3.NewFromConfig(cfg), getS3BucketName())
+	// HTTP_CACHE=true이면 크롤링 응답을 같은 버킷의 httpcache/ 아래에 캐시
+	if useCache, _ := strconv.ParseBool(os.Getenv("HTTP_CACHE")); useCache {
+		httpcache.Configure(store)
+	}
 
-	// S3에 업로드
-	_, err = s3Client.PutObject(context.TODO(), &s3.PutObjectInput{
-		Bucket:      aws.String(bucketName),
-		Key:         aws.String("index.html"),
-		Body:        bytes.NewReader(htmlBytes),
-		ContentType: aws.String("text/html"),
-	})
+	handler := job.NewHandler(store, getFilterDate())
+	handler.SetRetention(getRetention())
 
-	if err != nil {
-		log.Printf("S3 업로드 실패: %v", err)
-		return
+	// IMAGES=true이면 HTML의 포스트 이미지를 축소하여 같은 버킷의 images/ 아래에 저장
+	if useImages, _ := strconv.ParseBool(os.Getenv("IMAGES")); useImages {
+		handler.SetImages(images.NewProcessor(store))
 	}
 
-	log.Printf("✅ HTML 파일이 S3에 업로드되었습니다: s3://%s/%s", bucketName, "index.html")
-}
-
-func main() {
-	filterDate, err := time.Parse("2006-01-02", getFilterDate())
-	if err != nil {
-		log.Fatalf("날짜 파싱 실패: %v", err)
+	// coordinator 모드의 워커는 같은 함수를 다시 호출하여 실행
+	if functionName := os.Getenv("AWS_LAMBDA_FUNCTION_NAME"); functionName != "" {
+		handler.SetInvoker(job.NewLambdaInvoker(awslambda.NewFromConfig(cfg), functionName))
 	}
 
-	lambda.Start(func() {
-		internal.Crawl(
-			getFilterDate(),
-			uploadToS3,
-			crawlers.NewTossCrawler(filterDate),
-			crawlers.NewDaangnCrawler(),
-			crawlers.NewDanminCrawler(),
-			crawlers.NewNaverCrawler(),
-		)
-	})
+	lambda.Start(handler.Handle)
 }
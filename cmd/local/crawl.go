@@ -0,0 +1,277 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"hello-go/internal"
+	"hello-go/internal/config"
+	"hello-go/internal/crawlers"
+	"hello-go/internal/httpcache"
+	"hello-go/internal/images"
+	"hello-go/internal/models"
+	"hello-go/internal/notifiers"
+	"hello-go/internal/scheduler"
+	"hello-go/internal/snapshot"
+	"hello-go/internal/storage"
+)
+
+// defaultSince는 기본 필터 날짜입니다.
+const defaultSince = "2025-01-01"
+
+// defaultDataDir은 스냅샷 등 실행 데이터를 저장하는 기본 디렉터리입니다.
+const defaultDataDir = "data"
+
+// runCrawl은 크롤링하여 HTML 또는 JSON 파일을 생성합니다.
+func runCrawl(args []string) {
+	fs := flag.NewFlagSet("crawl", flag.ExitOnError)
+	sourceIDs := fs.String("source", "", "크롤링할 소스 ID (쉼표 구분, 기본값: 전체)")
+	since := fs.String("since", defaultSince, "이 날짜 이후의 포스트만 수집 (YYYY-MM-DD)")
+	out := fs.String("out", "", "출력 파일 경로 (기본값: index.html 또는 posts.json)")
+	format := fs.String("format", "html", "출력 형식 (html, json)")
+	snapshots := snapshotFlags(fs)
+	configPath := fs.String("config", "", "설정 파일 경로 (JSON)")
+	enrich := fs.Bool("enrich", false, "포스트마다 상세 페이지를 가져와 JSON-LD, OpenGraph 메타데이터로 보강")
+	selfHost := fs.Bool("images", false, "HTML의 포스트 이미지를 축소하여 출력 파일 옆 images/ 디렉터리에 저장")
+	policy := policyFlags(fs)
+	fetch := fetchFlags(fs)
+	_ = fs.Parse(args)
+	fetch.apply()
+
+	cfg := loadConfig(*configPath)
+
+	if _, ok := internal.OutputFiles[*format]; !ok {
+		log.Fatalf("지원하지 않는 형식: %s", *format)
+	}
+
+	sinceTime, err := time.Parse("2006-01-02", *since)
+	if err != nil {
+		log.Fatalf("날짜 파싱 실패: %v", err)
+	}
+
+	blogCrawlers, err := selectCrawlers(*sourceIDs, sinceTime)
+	if err != nil {
+		log.Fatalf("소스 선택 실패: %v", err)
+	}
+
+	log.Println("🚀 개발자들의 이야기 모음집 시작")
+	start := time.Now()
+
+	posts, run, err := internal.Collect(internal.Options{FilterDate: *since, Enrich: enricher(*enrich)}, blogCrawlers...)
+	if err != nil {
+		log.Fatalf("크롤링 실패: %v", err)
+	}
+	store := snapshots.store()
+	posts, err = applyBaseline(store, *policy, posts, &run)
+	if err == nil {
+		err = policy.Check(posts, run)
+	}
+	if err != nil {
+		log.Fatalf("⚠️  게시 중단: %v", err)
+	}
+
+	path, err := publish(cfg, store, *format, *out, snapshot.Snapshot{Run: run, Posts: posts}, *selfHost)
+	if err != nil {
+		log.Fatalf("출력 실패: %v", err)
+	}
+
+	log.Printf("🎉 완료! 총 소요시간: %v", time.Since(start))
+	log.Printf("📊 총 포스트 수: %d개 (필터링 후: %d개, 중복 제거 후: %d개)",
+		run.TotalCount, run.FilteredCount, run.UniqueCount)
+	log.Printf("📁 생성된 파일: %s", path)
+}
+
+// policyFlags는 게시 정책 플래그를 등록합니다.
+func policyFlags(fs *flag.FlagSet) *internal.Policy {
+	policy := &internal.Policy{}
+	fs.BoolVar(&policy.FailOnSourceError, "fail-on-source-error", false, "소스 하나라도 실패하면 게시하지 않음")
+	fs.IntVar(&policy.MinPosts, "min-posts", 0, "포스트가 이 수보다 적으면 게시하지 않음")
+	fs.BoolVar(&policy.AllowEmpty, "allow-empty", false, "포스트가 없어도 게시")
+	fs.Float64Var(&policy.MinSourceRatio, "min-source-ratio", 0, "소스의 포스트 수가 직전 실행의 이 비율 미만이면 --on-source-drop에 따라 처리 (0이면 확인 안 함)")
+	fs.StringVar(&policy.OnSourceDrop, "on-source-drop", internal.DropBlock, "포스트 수가 급감한 소스의 처리 방법 (block, carry)")
+	return policy
+}
+
+// enricher는 enabled이면 새 Enricher의 Enrich를, 아니면 nil을 반환합니다.
+func enricher(enabled bool) func(models.BlogPost) models.BlogPost {
+	if !enabled {
+		return nil
+	}
+	return crawlers.NewEnricher().Enrich
+}
+
+// fetchOptions는 크롤러의 외부 요청 설정입니다.
+type fetchOptions struct {
+	limits   scheduler.Limits
+	cacheDir string
+}
+
+// fetchFlags는 외부 요청 동시성 제한과 HTTP 캐시 플래그를 등록합니다. 파싱 후 apply로 적용합니다.
+func fetchFlags(fs *flag.FlagSet) *fetchOptions {
+	opts := &fetchOptions{}
+	fs.IntVar(&opts.limits.MaxInFlight, "max-in-flight", scheduler.DefaultMaxInFlight, "전체 크롤러가 동시에 보내는 최대 요청 수")
+	fs.IntVar(&opts.limits.PerHost, "per-host", scheduler.DefaultPerHost, "호스트 하나에 동시에 보내는 최대 요청 수")
+	fs.StringVar(&opts.cacheDir, "http-cache", "", "HTTP 응답을 캐시할 디렉터리 (비우면 캐시 안 함)")
+	return opts
+}
+
+// apply는 크롤러를 생성하기 전에 동시성 제한과 HTTP 캐시를 설정합니다.
+func (o *fetchOptions) apply() {
+	scheduler.Configure(o.limits)
+	if o.cacheDir != "" {
+		httpcache.Configure(storage.NewFileStorage(o.cacheDir))
+	}
+}
+
+// snapshotOptions는 스냅샷을 저장할 디렉터리와 보관 정책입니다.
+type snapshotOptions struct {
+	dataDir   string
+	retention snapshot.Retention
+}
+
+// snapshotFlags는 스냅샷 디렉터리와 보관 정책 플래그를 등록합니다.
+func snapshotFlags(fs *flag.FlagSet) *snapshotOptions {
+	opts := &snapshotOptions{}
+	fs.StringVar(&opts.dataDir, "data-dir", defaultDataDir, "스냅샷을 저장할 디렉터리 (비우면 저장하지 않음)")
+	fs.IntVar(&opts.retention.Keep, "keep-snapshots", snapshot.DefaultKeep, "보관할 최근 스냅샷 수 (0이면 제한 없음)")
+	fs.DurationVar(&opts.retention.MaxAge, "snapshot-max-age", 0, "이 기간보다 오래된 스냅샷을 삭제 (예: 720h, 0이면 제한 없음)")
+	return opts
+}
+
+// store는 보관 정책을 설정한 스냅샷 저장소를 반환합니다. 디렉터리가 비어 있으면 nil을 반환합니다.
+func (o *snapshotOptions) store() *snapshot.Store {
+	if o.dataDir == "" {
+		return nil
+	}
+	store := snapshot.NewStore(storage.NewFileStorage(o.dataDir))
+	store.SetRetention(o.retention)
+	return store
+}
+
+// applyBaseline은 수집 기준일이 같은 직전 스냅샷을 기준으로 포스트 수가 급감한 소스를 처리합니다.
+func applyBaseline(store *snapshot.Store, policy internal.Policy, posts []models.BlogPost, run *models.CrawlRun) ([]models.BlogPost, error) {
+	if store == nil || policy.MinSourceRatio <= 0 {
+		return posts, nil
+	}
+
+	previous, ok, err := store.LatestSince(run.FilterDate)
+	if err != nil {
+		return posts, err
+	}
+	if !ok {
+		return posts, nil
+	}
+	return policy.ApplyBaseline(previous.Posts, posts, run)
+}
+
+// loadConfig는 설정 파일을 읽고 설정된 선택자 소스를 크롤러 목록에 등록합니다. 경로가 비어 있으면 빈 설정을 반환합니다.
+func loadConfig(path string) config.Config {
+	if path == "" {
+		return config.Config{}
+	}
+	cfg, err := config.Load(path)
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	if err := crawlers.RegisterSources(cfg.Sources); err != nil {
+		log.Fatalf("소스 설정 오류: %v", err)
+	}
+	return cfg
+}
+
+// publish는 새 포스트를 표시한 결과물을 쓰고, 쓰기에 성공한 뒤에만 스냅샷을 저장하고 알림을 보냅니다.
+// 결과물을 쓰지 못한 실행이 스냅샷으로 남으면 다음 실행에서 그 포스트가 새 포스트로 표시되지 않기 때문입니다.
+// store가 nil이면 스냅샷을 기록하지 않습니다.
+func publish(cfg config.Config, store *snapshot.Store, format, out string, snap snapshot.Snapshot, selfHost bool) (string, error) {
+	var diff snapshot.Diff
+	if store != nil {
+		var err error
+		if diff, err = store.Mark(&snap); err != nil {
+			log.Printf("스냅샷 비교 실패: %v", err)
+			store = nil
+		}
+	}
+
+	path, err := writeOutput(format, out, snap, selfHost)
+	if err != nil {
+		return "", err
+	}
+
+	if store != nil && commitSnapshot(store, snap, diff) {
+		notify(cfg, diff.NewPosts())
+	}
+	return path, nil
+}
+
+// recordSnapshot은 직전 스냅샷과 비교하여 새 포스트를 표시하고 스냅샷을 저장합니다.
+// store가 nil이거나 기록에 실패하면 false를 반환합니다.
+func recordSnapshot(store *snapshot.Store, snap *snapshot.Snapshot) (snapshot.Diff, bool) {
+	if store == nil {
+		return snapshot.Diff{}, false
+	}
+
+	diff, err := store.Mark(snap)
+	if err != nil {
+		log.Printf("스냅샷 비교 실패: %v", err)
+		return snapshot.Diff{}, false
+	}
+	return diff, commitSnapshot(store, *snap, diff)
+}
+
+// commitSnapshot은 표시를 마친 스냅샷을 저장합니다. 실패하면 false를 반환합니다.
+func commitSnapshot(store *snapshot.Store, snap snapshot.Snapshot, diff snapshot.Diff) bool {
+	if err := store.Commit(snap, diff); err != nil {
+		log.Printf("스냅샷 기록 실패: %v", err)
+		return false
+	}
+	if diff.HasPrevious {
+		log.Printf("🆕 지난 실행 이후 새 포스트: %d개", len(diff.NewPosts()))
+	}
+	return true
+}
+
+// notify는 설정된 알림 채널로 새 포스트를 보냅니다.
+func notify(cfg config.Config, posts []models.BlogPost) {
+	if len(cfg.Notifiers) == 0 || len(posts) == 0 {
+		return
+	}
+
+	dispatcher, err := notifiers.NewDispatcherFromConfig(cfg.Notifiers)
+	if err != nil {
+		log.Printf("알림 설정 오류: %v", err)
+		return
+	}
+	if err := dispatcher.Dispatch(posts); err != nil {
+		log.Printf("알림 전송 실패: %v", err)
+	}
+}
+
+// writeOutput은 스냅샷을 지정된 형식으로 파일에 쓰고 파일 경로를 반환합니다.
+// selfHost이면 HTML의 포스트 이미지를 출력 파일과 같은 디렉터리의 images/ 아래 사본으로 바꿉니다.
+func writeOutput(format, out string, snap snapshot.Snapshot, selfHost bool) (string, error) {
+	if out == "" {
+		out = internal.OutputFiles[format]
+	}
+
+	if selfHost && format == "html" {
+		posts, err := images.NewProcessor(storage.NewFileStorage(filepath.Dir(out))).Apply(snap.Posts)
+		if err != nil {
+			return "", err
+		}
+		snap.Posts = posts
+	}
+
+	data, err := internal.Render(format, snap)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.WriteFile(out, data, 0o644); err != nil {
+		return "", fmt.Errorf("파일 쓰기 실패: %w", err)
+	}
+	return out, nil
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"hello-go/internal/config"
+	"hello-go/internal/models"
+	"hello-go/internal/snapshot"
+	"hello-go/internal/storage"
+)
+
+func TestPublishRecordsSnapshotOnlyAfterOutput(t *testing.T) {
+	dataDir := t.TempDir()
+	store := snapshot.NewStore(storage.NewFileStorage(dataDir))
+	snap := snapshot.Snapshot{Run: models.CrawlRun{FilterDate: "2025-01-01"}, Posts: []models.BlogPost{newPost}}
+
+	// 출력 디렉터리가 없어 쓰기에 실패하면 스냅샷을 남기지 않음
+	missing := filepath.Join(t.TempDir(), "missing", "posts.json")
+	if _, err := publish(config.Config{}, store, "json", missing, snap, false); err == nil {
+		t.Fatal("쓰기 실패인데 오류 없음")
+	}
+	if keys, err := store.Keys(); err != nil || len(keys) != 0 {
+		t.Fatalf("실패한 실행의 스냅샷 %v (%v)", keys, err)
+	}
+
+	out := filepath.Join(t.TempDir(), "posts.json")
+	path, err := publish(config.Config{}, store, "json", out, snap, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(path); err != nil {
+		t.Errorf("출력 파일 없음: %v", err)
+	}
+	if keys, err := store.Keys(); err != nil || len(keys) != 1 {
+		t.Errorf("스냅샷 %v (%v), want 1개", keys, err)
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"hello-go/internal/snapshot"
+	"hello-go/internal/storage"
+)
+
+// runDiff는 두 스냅샷을 비교하여 소스별로 추가, 삭제, 변경된 포스트를 출력합니다.
+func runDiff(args []string) {
+	fs := flag.NewFlagSet("diff", flag.ExitOnError)
+	dataDir := fs.String("data-dir", defaultDataDir, "파일을 지정하지 않을 때 최근 두 스냅샷을 찾을 디렉터리")
+	format := fs.String("format", "text", "출력 형식 (text, json)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "사용법: diff [옵션] [<이전 스냅샷> <새 스냅샷>]")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	var oldSnap, newSnap snapshot.Snapshot
+	var err error
+	switch fs.NArg() {
+	case 0:
+		oldSnap, newSnap, err = latestTwoSnapshots(*dataDir)
+	case 2:
+		if oldSnap, err = snapshot.Read(fs.Arg(0)); err == nil {
+			newSnap, err = snapshot.Read(fs.Arg(1))
+		}
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	diff := snapshot.Compare(oldSnap, newSnap)
+
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(diff); err != nil {
+			log.Fatalf("JSON 출력 실패: %v", err)
+		}
+		return
+	}
+
+	for _, sd := range diff.Sources {
+		fmt.Printf("## %s (추가 %d, 삭제 %d, 변경 %d)\n", sd.Source, len(sd.Added), len(sd.Removed), len(sd.Modified))
+		for _, post := range sd.Added {
+			fmt.Printf("+ %s (%s)\n", post.Title, post.URL)
+		}
+		for _, post := range sd.Removed {
+			fmt.Printf("- %s (%s)\n", post.Title, post.URL)
+		}
+		for _, change := range sd.Modified {
+			fmt.Printf("~ %s [%s] (%s)\n", change.Current.Title, strings.Join(change.Fields, ", "), change.Current.URL)
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("추가 %d개, 삭제 %d개, 변경 %d개\n", len(diff.Added()), len(diff.Removed()), len(diff.Modified()))
+}
+
+// latestTwoSnapshots는 데이터 디렉터리에서 가장 최근 두 스냅샷을 읽습니다.
+func latestTwoSnapshots(dataDir string) (snapshot.Snapshot, snapshot.Snapshot, error) {
+	store := snapshot.NewStore(storage.NewFileStorage(dataDir))
+	keys, err := store.Keys()
+	if err != nil {
+		return snapshot.Snapshot{}, snapshot.Snapshot{}, err
+	}
+	if len(keys) < 2 {
+		return snapshot.Snapshot{}, snapshot.Snapshot{}, fmt.Errorf("비교할 스냅샷이 부족합니다: %d개", len(keys))
+	}
+
+	oldSnap, err := store.Load(keys[len(keys)-2])
+	if err != nil {
+		return snapshot.Snapshot{}, snapshot.Snapshot{}, err
+	}
+	newSnap, err := store.Load(keys[len(keys)-1])
+	if err != nil {
+		return snapshot.Snapshot{}, snapshot.Snapshot{}, err
+	}
+	return oldSnap, newSnap, nil
+}
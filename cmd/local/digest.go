@@ -0,0 +1,49 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"time"
+
+	"hello-go/internal/digest"
+	"hello-go/internal/snapshot"
+	"hello-go/internal/storage"
+)
+
+// runDigest는 최근 스냅샷의 포스트로 이메일 다이제스트를 만들어 보냅니다.
+func runDigest(args []string) {
+	fs := flag.NewFlagSet("digest", flag.ExitOnError)
+	configPath := fs.String("config", "config.json", "설정 파일 경로 (JSON)")
+	dataDir := fs.String("data-dir", defaultDataDir, "스냅샷을 읽을 디렉터리")
+	dryRun := fs.Bool("dry-run", false, "메일을 보내지 않고 .eml 파일로 저장")
+	outDir := fs.String("out-dir", "digests", "dry-run에서 .eml 파일을 저장할 디렉터리")
+	_ = fs.Parse(args)
+
+	cfg := loadConfig(*configPath)
+	if len(cfg.Digest.Recipients) == 0 {
+		log.Fatalf("digest.recipients가 비어 있습니다.")
+	}
+
+	snap, ok, err := snapshot.NewStore(storage.NewFileStorage(*dataDir)).Latest()
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+	if !ok {
+		log.Fatalf("%s에 스냅샷이 없습니다. 먼저 crawl을 실행하세요.", *dataDir)
+	}
+
+	var sender digest.Sender
+	if *dryRun {
+		sender = digest.NewDryRunSender(*outDir)
+	} else {
+		sender = digest.NewSMTPSender(cfg.Digest.SMTP)
+	}
+
+	mailer, err := digest.NewMailer(cfg.Digest, sender)
+	if err != nil {
+		log.Fatalf("다이제스트 설정 오류: %v", err)
+	}
+	if err := mailer.Send(snap.Posts, time.Now()); err != nil {
+		log.Fatalf("다이제스트 전송 실패: %v", err)
+	}
+}
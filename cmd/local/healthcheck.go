@@ -0,0 +1,85 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"hello-go/internal/crawlers"
+	"hello-go/internal/health"
+	"hello-go/internal/storage"
+)
+
+// runHealthcheck는 크롤러를 실행하여 소스별 필드 완성도를 기대치와 비교하고 JSON 보고서를 출력합니다.
+// 비정상 소스가 있으면 종료 코드 1로 끝납니다.
+func runHealthcheck(args []string) {
+	fs := flag.NewFlagSet("healthcheck", flag.ExitOnError)
+	sourceIDs := fs.String("source", "", "확인할 소스 ID (쉼표 구분, 기본값: 전체)")
+	since := fs.String("since", defaultSince, "토스 페이지네이션 기준 날짜 (YYYY-MM-DD)")
+	dataDir := fs.String("data-dir", defaultDataDir, "기대치와 보고서를 저장할 디렉터리")
+	tolerance := fs.Float64("tolerance", 0.1, "기대치 대비 허용하는 완성도 하락폭 (0~1)")
+	update := fs.Bool("update-expectations", false, "정상 소스의 이번 결과를 새 기대치로 저장")
+	out := fs.String("out", "", "보고서 파일 경로 (기본값: 표준 출력)")
+	configPath := fs.String("config", "", "선택자 소스를 등록할 설정 파일 경로 (JSON)")
+	fetch := fetchFlags(fs)
+	_ = fs.Parse(args)
+	fetch.apply()
+
+	loadConfig(*configPath)
+
+	sinceTime, err := time.Parse("2006-01-02", *since)
+	if err != nil {
+		log.Fatalf("날짜 파싱 실패: %v", err)
+	}
+
+	var ids []string
+	if strings.TrimSpace(*sourceIDs) != "" {
+		ids = strings.Split(*sourceIDs, ",")
+	}
+	entries, err := crawlers.SelectEntries(ids)
+	if err != nil {
+		log.Fatalf("소스 선택 실패: %v", err)
+	}
+
+	data := storage.NewFileStorage(*dataDir)
+	expectations, err := health.LoadExpectations(data)
+	if err != nil {
+		log.Fatalf("기대치 로드 실패: %v", err)
+	}
+
+	checker := health.Checker{Expectations: expectations, Tolerance: *tolerance}
+	report := checker.Run(entries, sinceTime)
+
+	if err := health.SaveReport(data, report); err != nil {
+		log.Printf("보고서 저장 실패: %v", err)
+	}
+	if *update {
+		if _, err := health.SaveExpectations(data, report, expectations); err != nil {
+			log.Fatalf("기대치 저장 실패: %v", err)
+		}
+		log.Printf("📐 기대치 저장: %s/%s", *dataDir, health.ExpectationsKey)
+	}
+
+	output := os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("파일 생성 실패: %v", err)
+		}
+		defer f.Close()
+		output = f
+	}
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatalf("보고서 출력 실패: %v", err)
+	}
+
+	if !report.Healthy {
+		log.Println("❌ 비정상 소스가 있습니다.")
+		os.Exit(1)
+	}
+}
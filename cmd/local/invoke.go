@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"hello-go/internal/job"
+	"hello-go/internal/storage"
+)
+
+// runInvoke는 Lambda 핸들러를 S3 대신 로컬 디렉터리를 저장소로 사용하여 실행합니다.
+func runInvoke(args []string) {
+	fs := flag.NewFlagSet("invoke", flag.ExitOnError)
+	dataDir := fs.String("data-dir", defaultDataDir, "S3 버킷 대신 사용할 디렉터리")
+	eventJSON := fs.String("event", "{}", "Lambda 이벤트 JSON")
+	since := fs.String("since", defaultSince, "이벤트에 since가 없을 때의 기준 날짜 (FILTER_DATE)")
+	fetch := fetchFlags(fs)
+	_ = fs.Parse(args)
+	fetch.apply()
+
+	var event job.Event
+	if err := json.Unmarshal([]byte(*eventJSON), &event); err != nil {
+		log.Fatalf("이벤트 파싱 실패: %v", err)
+	}
+
+	handler := job.NewHandler(storage.NewFileStorage(*dataDir), *since)
+	report, err := handler.Handle(context.Background(), event)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	_ = encoder.Encode(report)
+
+	if err != nil {
+		log.Fatalf("실행 실패: %v", err)
+	}
+}
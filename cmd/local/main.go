@@ -1,37 +1,56 @@
 package main
 
 import (
-	"log"
+	"fmt"
 	"os"
-
-	"hello-go/internal"
-	"hello-go/internal/crawlers"
 )
 
-func writeFile(html string) {
-	// 로컬 파일로도 저장 (디버깅용)
-	file, err := os.Create("index.html")
-	if err != nil {
-		log.Fatalf("파일 생성 실패: %v", err)
-	}
-	defer file.Close()
-	if _, err := file.WriteString(html); err != nil {
-		log.Fatalf("파일 쓰기 실패: %v", err)
-	}
+// commands는 서브커맨드 이름과 실행 함수의 목록입니다.
+var commands = map[string]func(args []string){
+	"crawl":       runCrawl,
+	"sources":     runSources,
+	"validate":    runValidate,
+	"render":      runRender,
+	"diff":        runDiff,
+	"serve":       runServe,
+	"digest":      runDigest,
+	"invoke":      runInvoke,
+	"healthcheck": runHealthcheck,
+}
+
+func usage() {
+	fmt.Fprint(os.Stderr, `사용법: local <명령> [옵션]
+
+명령:
+  crawl        크롤링하여 HTML 또는 JSON 파일 생성 (--source, --since, --out, --format)
+  sources      설정된 크롤러와 소스 정보 출력
+  validate     크롤러 하나를 실행하고 파싱된 포스트를 표 또는 JSON으로 출력
+  render       크롤링 없이 저장된 스냅샷으로 출력물 다시 생성
+  diff         두 스냅샷 비교
+  serve        주기적으로 크롤링하고 사이트와 API를 HTTP로 제공
+  digest       최근 스냅샷으로 이메일 다이제스트 전송
+  invoke       Lambda 핸들러를 로컬 디렉터리를 저장소로 사용하여 실행
+  healthcheck  크롤러별 필수 필드 완성도를 기대치와 비교하여 JSON 보고서 출력
+
+각 명령의 옵션은 'local <명령> -h'로 확인할 수 있습니다.
+`)
 }
 
 func main() {
-	// filterDate, err := time.Parse("2006-01-02", "2025-01-01")
-	// if err != nil {
-	// 	log.Fatalf("날짜 파싱 실패: %v", err)
-	// }
-
-	internal.Crawl(
-		"2025-01-01",
-		writeFile,
-		//crawlers.NewTossCrawler(filterDate),
-		//crawlers.NewDaangnCrawler(),
-		crawlers.NewDanminCrawler(),
-		//crawlers.NewNaverCrawler(),
-	)
+	// 명령 없이 실행하면 기본 옵션으로 크롤링
+	if len(os.Args) < 2 {
+		runCrawl(nil)
+		return
+	}
+
+	command, ok := commands[os.Args[1]]
+	if !ok {
+		if os.Args[1] != "-h" && os.Args[1] != "--help" && os.Args[1] != "help" {
+			fmt.Fprintf(os.Stderr, "알 수 없는 명령: %s\n\n", os.Args[1])
+		}
+		usage()
+		os.Exit(2)
+	}
+
+	command(os.Args[2:])
 }
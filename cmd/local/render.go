@@ -0,0 +1,39 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"hello-go/internal/snapshot"
+)
+
+// runRender는 크롤링 없이 저장된 스냅샷으로 출력물을 다시 생성합니다.
+func runRender(args []string) {
+	fs := flag.NewFlagSet("render", flag.ExitOnError)
+	out := fs.String("out", "", "출력 파일 경로 (기본값: index.html 또는 posts.json)")
+	format := fs.String("format", "html", "출력 형식 (html, json)")
+	selfHost := fs.Bool("images", false, "HTML의 포스트 이미지를 축소하여 출력 파일 옆 images/ 디렉터리에 저장")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "사용법: render [옵션] <스냅샷 파일>")
+		fs.PrintDefaults()
+	}
+	_ = fs.Parse(args)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	snap, err := snapshot.Read(fs.Arg(0))
+	if err != nil {
+		log.Fatalf("%v", err)
+	}
+
+	path, err := writeOutput(*format, *out, snap, *selfHost)
+	if err != nil {
+		log.Fatalf("출력 실패: %v", err)
+	}
+	log.Printf("📁 생성된 파일: %s (%d개 포스트)", path, len(snap.Posts))
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"hello-go/internal"
+	"hello-go/internal/config"
+	"hello-go/internal/models"
+	"hello-go/internal/server"
+	"hello-go/internal/snapshot"
+	"hello-go/internal/store"
+)
+
+// runServe는 주기적으로 크롤링을 실행하고 결과를 HTTP로 제공합니다.
+func runServe(args []string) {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	addr := fs.String("addr", ":8080", "HTTP 서버 주소")
+	interval := fs.Duration("interval", time.Hour, "크롤링 주기")
+	sourceIDs := fs.String("source", "", "크롤링할 소스 ID (쉼표 구분, 기본값: 전체)")
+	since := fs.String("since", defaultSince, "이 날짜 이후의 포스트만 수집 (YYYY-MM-DD)")
+	snapshots := snapshotFlags(fs)
+	configPath := fs.String("config", "", "설정 파일 경로 (JSON)")
+	enrich := fs.Bool("enrich", false, "포스트마다 상세 페이지를 가져와 JSON-LD, OpenGraph 메타데이터로 보강")
+	policy := policyFlags(fs)
+	fetch := fetchFlags(fs)
+	_ = fs.Parse(args)
+	fetch.apply()
+
+	cfg := loadConfig(*configPath)
+
+	sinceTime, err := time.Parse("2006-01-02", *since)
+	if err != nil {
+		log.Fatalf("날짜 파싱 실패: %v", err)
+	}
+
+	blogCrawlers, err := selectCrawlers(*sourceIDs, sinceTime)
+	if err != nil {
+		log.Fatalf("소스 선택 실패: %v", err)
+	}
+
+	postStore := store.NewPostStore()
+	srv := server.NewServer(postStore)
+
+	r := &refresher{
+		store:     postStore,
+		server:    srv,
+		crawlers:  blogCrawlers,
+		options:   internal.Options{FilterDate: *since, Enrich: enricher(*enrich)},
+		snapshots: snapshots.store(),
+		policy:    *policy,
+		config:    cfg,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		r.refresh(ctx)
+		ticker := time.NewTicker(*interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.refresh(ctx)
+			}
+		}
+	}()
+
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           srv,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if err := httpServer.Shutdown(shutdownCtx); err != nil {
+			log.Printf("서버 종료 실패: %v", err)
+		}
+	}()
+
+	log.Printf("🌐 서버 시작: %s (크롤링 주기: %v)", *addr, *interval)
+	if err := httpServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		log.Fatalf("서버 실행 실패: %v", err)
+	}
+}
+
+// refresher는 serve 모드의 예약된 크롤링 한 번을 실행하고 저장소를 갱신합니다.
+type refresher struct {
+	store     *store.PostStore
+	server    *server.Server
+	crawlers  []models.BlogCrawler
+	options   internal.Options
+	snapshots *snapshot.Store
+	policy    internal.Policy
+	config    config.Config
+}
+
+// refresh는 크롤링을 실행합니다. 파이프라인을 통과한 포스트는 바로 저장소에 추가되어 크롤링이 끝나기 전부터 제공되며,
+// 크롤링이 끝나면 전체 결과로 교체하여 사라진 포스트를 정리합니다.
+// 크롤링이 실패하거나 정책을 위반하면 크롤링 전 상태로 되돌리므로 이전 포스트가 그대로 남습니다.
+func (r *refresher) refresh(ctx context.Context) {
+	log.Println("🔄 예약된 크롤링 시작")
+	restore := r.store.Checkpoint()
+
+	var posts []models.BlogPost
+	pipeline := internal.Pipeline{
+		Options: r.options,
+		Sink: func(post models.BlogPost) error {
+			posts = append(posts, post)
+			r.store.Upsert(post)
+			return nil
+		},
+	}
+	run, err := pipeline.Run(ctx, r.crawlers...)
+	if err != nil {
+		restore()
+		log.Printf("크롤링 실패: %v", err)
+		return
+	}
+	posts, err = applyBaseline(r.snapshots, r.policy, posts, &run)
+	if err == nil {
+		err = r.policy.Check(posts, run)
+	}
+	if err != nil {
+		restore()
+		log.Printf("⚠️  갱신 중단: %v", err)
+		return
+	}
+	snap := snapshot.Snapshot{Run: run, Posts: posts}
+	if diff, ok := recordSnapshot(r.snapshots, &snap); ok {
+		notify(r.config, diff.NewPosts())
+	}
+	r.store.Replace(snap.Posts, run)
+
+	html, err := internal.GenerateHTML(snap.Posts, internal.BlogStats(snap.Posts))
+	if err != nil {
+		log.Printf("HTML 생성 실패: %v", err)
+		return
+	}
+	r.server.SetHTML(html)
+	log.Printf("✅ 저장소 갱신 완료: %d개 포스트", len(posts))
+}
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"hello-go/internal"
+	"hello-go/internal/models"
+	"hello-go/internal/server"
+	"hello-go/internal/store"
+)
+
+// fixtureCrawler는 네트워크 없이 정해진 포스트를 반환하는 크롤러입니다.
+type fixtureCrawler struct {
+	posts []models.BlogPost
+}
+
+func (c fixtureCrawler) Crawl() ([]models.BlogPost, error) { return c.posts, nil }
+func (c fixtureCrawler) GetSource() models.BlogSource {
+	return models.BlogSource{Name: "픽스처", URL: "https://fixture.example.com"}
+}
+
+func newTestRefresher(policy internal.Policy, posts ...models.BlogPost) *refresher {
+	postStore := store.NewPostStore()
+	postStore.Replace([]models.BlogPost{
+		{Title: "이전 포스트", URL: "https://fixture.example.com/old", Source: "픽스처", PublishedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}, models.CrawlRun{FilterDate: "2025-01-01"})
+
+	return &refresher{
+		store:    postStore,
+		server:   server.NewServer(postStore),
+		crawlers: []models.BlogCrawler{fixtureCrawler{posts: posts}},
+		options:  internal.Options{FilterDate: "2025-01-01"},
+		policy:   policy,
+	}
+}
+
+var newPost = models.BlogPost{
+	Title:       "새 포스트",
+	URL:         "https://fixture.example.com/new",
+	Source:      "픽스처",
+	PublishedAt: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+}
+
+func titles(page store.PostPage) []string {
+	var result []string
+	for _, post := range page.Posts {
+		result = append(result, post.Title)
+	}
+	return result
+}
+
+func TestRefreshRollsBackRejectedCrawl(t *testing.T) {
+	r := newTestRefresher(internal.Policy{MinPosts: 2}, newPost)
+	r.refresh(context.Background())
+
+	page := r.store.Query(store.PostQuery{})
+	if got := titles(page); len(got) != 1 || got[0] != "이전 포스트" {
+		t.Errorf("정책 위반 후 포스트 = %v, want [이전 포스트]", got)
+	}
+}
+
+func TestRefreshReplacesPostsOnSuccess(t *testing.T) {
+	r := newTestRefresher(internal.Policy{}, newPost)
+	r.refresh(context.Background())
+
+	page := r.store.Query(store.PostQuery{})
+	if got := titles(page); len(got) != 1 || got[0] != "새 포스트" {
+		t.Errorf("갱신 후 포스트 = %v, want [새 포스트]", got)
+	}
+}
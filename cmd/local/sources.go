@@ -0,0 +1,47 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"hello-go/internal/crawlers"
+	"hello-go/internal/models"
+)
+
+// selectCrawlers는 쉼표로 구분된 소스 ID로 크롤러를 생성합니다. 비어 있으면 전체 크롤러를 반환합니다.
+func selectCrawlers(ids string, since time.Time) ([]models.BlogCrawler, error) {
+	opts := crawlers.Options{Since: since}
+	if strings.TrimSpace(ids) == "" {
+		return crawlers.Select(nil, opts)
+	}
+	return crawlers.Select(strings.Split(ids, ","), opts)
+}
+
+// runSources는 등록된 크롤러와 지원 기능을 출력합니다.
+func runSources(args []string) {
+	fs := flag.NewFlagSet("sources", flag.ExitOnError)
+	configPath := fs.String("config", "", "선택자 소스를 등록할 설정 파일 경로 (JSON)")
+	_ = fs.Parse(args)
+
+	loadConfig(*configPath)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\t이름\tSINCE\t페이지네이션\t상세 요청\tURL")
+	for _, entry := range crawlers.Entries() {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", entry.ID, entry.Name,
+			mark(entry.SupportsSince), mark(entry.SupportsPagination), mark(entry.NeedsDetailFetch), entry.Homepage)
+	}
+	_ = w.Flush()
+}
+
+// mark는 지원 여부를 표 칸에 표시할 기호로 변환합니다.
+func mark(ok bool) string {
+	if ok {
+		return "✓"
+	}
+	return "-"
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"hello-go/internal/crawlers"
+)
+
+// runValidate는 크롤러 하나를 실행하고 파싱된 포스트를 출력합니다.
+func runValidate(args []string) {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	since := fs.String("since", defaultSince, "토스 페이지네이션 기준 날짜 (YYYY-MM-DD)")
+	format := fs.String("format", "table", "출력 형식 (table, json)")
+	configPath := fs.String("config", "", "선택자 소스를 등록할 설정 파일 경로 (JSON)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "사용법: validate [옵션] <소스 ID>")
+		fs.PrintDefaults()
+	}
+	fetch := fetchFlags(fs)
+	_ = fs.Parse(args)
+	fetch.apply()
+
+	loadConfig(*configPath)
+
+	if fs.NArg() != 1 {
+		fs.Usage()
+		os.Exit(2)
+	}
+
+	entry, ok := crawlers.Find(fs.Arg(0))
+	if !ok {
+		log.Fatalf("알 수 없는 소스: %s", fs.Arg(0))
+	}
+
+	sinceTime, err := time.Parse("2006-01-02", *since)
+	if err != nil {
+		log.Fatalf("날짜 파싱 실패: %v", err)
+	}
+
+	posts, err := entry.NewCrawler(crawlers.Options{Since: sinceTime}).Crawl()
+	if err != nil {
+		log.Fatalf("크롤링 실패: %v", err)
+	}
+
+	switch *format {
+	case "json":
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(posts); err != nil {
+			log.Fatalf("JSON 출력 실패: %v", err)
+		}
+	case "table":
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "날짜\t카테고리\t작성자\t이미지\t제목\tURL")
+		for _, post := range posts {
+			image := "-"
+			if post.Image != "" {
+				image = "O"
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n",
+				post.PublishedAt.Format("2006-01-02"), post.Category, post.Author, image, post.Title, post.URL)
+		}
+		_ = w.Flush()
+		fmt.Printf("\n총 %d개 포스트\n", len(posts))
+	default:
+		log.Fatalf("지원하지 않는 형식: %s", *format)
+	}
+}
@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"fmt"
+	"log"
+	"sort"
+
+	"hello-go/internal/models"
+	"hello-go/internal/snapshot"
+)
+
+// 소스의 포스트 수가 급감했을 때의 처리 방법입니다.
+const (
+	// DropBlock은 게시를 중단합니다. (기본값)
+	DropBlock = "block"
+	// DropCarry는 해당 소스의 직전 포스트를 가져와 합칩니다.
+	DropCarry = "carry"
+)
+
+// SourceDrop은 직전 실행보다 포스트 수가 급감한 소스입니다.
+type SourceDrop struct {
+	Source   string
+	Previous int
+	Current  int
+}
+
+func (d SourceDrop) String() string {
+	return fmt.Sprintf("%s 포스트 수가 %d개에서 %d개로 줄었습니다", d.Source, d.Previous, d.Current)
+}
+
+// SourceDrops는 직전 포스트 수 대비 minRatio 미만으로 줄어든 소스 목록을 소스 이름순으로 반환합니다.
+func SourceDrops(previous, current []models.BlogPost, minRatio float64) []SourceDrop {
+	previousCounts := BlogStats(previous)
+	currentCounts := BlogStats(current)
+
+	var drops []SourceDrop
+	for source, count := range previousCounts {
+		if float64(currentCounts[source]) < float64(count)*minRatio {
+			drops = append(drops, SourceDrop{Source: source, Previous: count, Current: currentCounts[source]})
+		}
+	}
+	sort.Slice(drops, func(i, j int) bool {
+		return drops[i].Source < drops[j].Source
+	})
+	return drops
+}
+
+// ApplyBaseline은 직전 실행의 소스별 포스트 수를 기준으로 이번 실행에서 크롤링한 소스 중 급감한 소스를 찾아 run.Warnings에 기록합니다.
+// OnSourceDrop이 carry이면 해당 소스의 직전 포스트를 합친 목록을 반환하고, 그 외에는 *PolicyError를 반환합니다.
+// previous는 이번 실행과 수집 기간이 같은 포스트여야 하며, 호출하는 쪽에서 골라 넘깁니다.
+// MinSourceRatio가 0이면 아무것도 하지 않습니다.
+func (p Policy) ApplyBaseline(previous, posts []models.BlogPost, run *models.CrawlRun) ([]models.BlogPost, error) {
+	if p.MinSourceRatio <= 0 || len(previous) == 0 {
+		return posts, nil
+	}
+	if p.OnSourceDrop != "" && p.OnSourceDrop != DropBlock && p.OnSourceDrop != DropCarry {
+		return posts, fmt.Errorf("지원하지 않는 on_source_drop: %s", p.OnSourceDrop)
+	}
+
+	// 이번 실행에서 크롤링한 소스만 비교
+	crawled := make(map[string]bool)
+	for _, source := range run.Sources {
+		crawled[source.Name] = true
+	}
+	var drops []SourceDrop
+	for _, drop := range SourceDrops(previous, posts, p.MinSourceRatio) {
+		if crawled[drop.Source] {
+			drops = append(drops, drop)
+		}
+	}
+	if len(drops) == 0 {
+		return posts, nil
+	}
+
+	if p.OnSourceDrop != DropCarry {
+		var violations []string
+		for _, drop := range drops {
+			warning := drop.String()
+			log.Printf("⚠️  %s", warning)
+			run.Warnings = append(run.Warnings, warning)
+			violations = append(violations, warning)
+		}
+		return posts, &PolicyError{Violations: violations}
+	}
+
+	dropped := make(map[string]bool)
+	for _, drop := range drops {
+		warning := drop.String() + " (직전 포스트 유지)"
+		log.Printf("⚠️  %s", warning)
+		run.Warnings = append(run.Warnings, warning)
+		dropped[drop.Source] = true
+	}
+
+	var kept []models.BlogPost
+	for _, post := range previous {
+		if dropped[post.Source] {
+			kept = append(kept, post)
+		}
+	}
+	return snapshot.Merge(kept, posts), nil
+}
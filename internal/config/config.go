@@ -0,0 +1,189 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// Config는 JSON 설정 파일의 내용을 담는 구조체입니다.
+type Config struct {
+	Notifiers []NotifierConfig `json:"notifiers"`
+	Digest    DigestConfig     `json:"digest"`
+	Sources   []SourceConfig   `json:"sources"`
+}
+
+// NotifierConfig는 알림 채널 하나의 설정입니다.
+type NotifierConfig struct {
+	// Name은 로그에 표시할 채널 이름입니다.
+	Name string `json:"name"`
+	// Type은 알림 종류입니다. (slack, discord, webhook)
+	Type string `json:"type"`
+	URL  string `json:"url"`
+	// Sources와 Categories가 비어 있지 않으면 해당 소스/카테고리의 포스트만 알립니다.
+	Sources    []string `json:"sources"`
+	Categories []string `json:"categories"`
+	// Template은 메시지 본문을 만드는 text/template 문자열입니다. 비어 있으면 기본 템플릿을 사용합니다.
+	Template string `json:"template"`
+	// BatchSize는 메시지 하나에 담을 최대 포스트 수입니다.
+	BatchSize  int `json:"batch_size"`
+	MaxRetries int `json:"max_retries"`
+}
+
+// DigestConfig는 이메일 다이제스트 설정입니다.
+type DigestConfig struct {
+	// WindowDays는 다이제스트에 포함할 포스트의 발행 기간(일)입니다.
+	WindowDays int    `json:"window_days"`
+	From       string `json:"from"`
+	// Subject는 제목 text/template 문자열입니다. 비어 있으면 기본 제목을 사용합니다.
+	Subject    string            `json:"subject"`
+	SMTP       SMTPConfig        `json:"smtp"`
+	Recipients []RecipientConfig `json:"recipients"`
+}
+
+// SMTPConfig는 SMTP 서버 접속 정보입니다.
+type SMTPConfig struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// RecipientConfig는 다이제스트 수신자 설정입니다.
+type RecipientConfig struct {
+	Email string `json:"email"`
+	Name  string `json:"name"`
+	// Sources가 비어 있지 않으면 해당 소스의 포스트만 받습니다.
+	Sources []string `json:"sources"`
+}
+
+// SourceConfig는 CSS 선택자만으로, 또는 Medium publication으로 크롤링하는 소스 설정입니다.
+type SourceConfig struct {
+	// ID는 --source 등에서 사용하는 소스 ID입니다. 기본 크롤러와 같은 ID면 기본 크롤러를 대체합니다.
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// URL은 블로그 홈페이지 주소이며, 상대 URL의 기준이 됩니다.
+	URL    string `json:"url"`
+	Author string `json:"author"`
+	// Medium이 있으면 선택자 대신 이 Medium publication을 RSS 피드와 아카이브로 크롤링합니다. (예: daangn)
+	Medium string `json:"medium"`
+	// ListingURLs는 포스트 목록 페이지 주소 목록입니다.
+	ListingURLs []string         `json:"listing_urls"`
+	Pagination  PaginationConfig `json:"pagination"`
+	// Item은 목록 페이지에서 포스트 하나에 해당하는 요소의 선택자입니다.
+	Item string `json:"item"`
+	// NextData가 있으면 Item 대신 목록 페이지의 Next.js 데이터에서 포스트를 추출합니다.
+	NextData *NextDataConfig `json:"next_data"`
+	// ItemURLPattern이 있으면 URL이 이 정규식과 일치하는 항목만 수집합니다.
+	ItemURLPattern string `json:"item_url_pattern"`
+	// ExcludeTitles는 수집하지 않을 제목 목록입니다. (대소문자 무시)
+	ExcludeTitles []string `json:"exclude_titles"`
+	// Fields는 Item 요소 안에서 각 필드를 찾는 선택자입니다.
+	Fields FieldSelectors `json:"fields"`
+	// Detail이 있으면 포스트 상세 페이지를 요청하여 비어 있는 필드를 채웁니다.
+	Detail *FieldSelectors `json:"detail"`
+	// DateFormat은 날짜 텍스트의 Go time 레이아웃입니다. (예: 2006.01.02)
+	DateFormat string `json:"date_format"`
+	// Categories는 찾은 카테고리 텍스트를 분류하는 규칙이며, 처음 일치하는 규칙을 사용합니다.
+	Categories      []CategoryRule `json:"categories"`
+	DefaultCategory string         `json:"default_category"`
+	DefaultSummary  string         `json:"default_summary"`
+}
+
+// NextDataConfig는 Next.js __NEXT_DATA__ 또는 React Query dehydrated state에서 포스트를 추출하는 설정입니다.
+// 경로는 JSONPath 형식이며, 경로 중간의 JSON 문자열은 자동으로 디코딩됩니다.
+type NextDataConfig struct {
+	// Marker는 __NEXT_DATA__ 스크립트가 없을 때 데이터가 들어 있는 스크립트를 찾는 문자열입니다. (예: dehydratedState)
+	Marker string `json:"marker"`
+	// Posts는 포스트 객체 목록의 경로입니다. (예: $..queries[*].state.data.results[*])
+	Posts string `json:"posts"`
+	// Fields는 포스트 객체 기준 필드별 경로 목록이며, 처음으로 값이 있는 경로를 사용합니다.
+	Fields NextDataFields `json:"fields"`
+	// URLTemplate이 있으면 URL 필드 값으로 {value}를 치환하여 포스트 URL을 만듭니다. (예: https://toss.tech/article/{value})
+	URLTemplate string `json:"url_template"`
+	// Categories가 있으면 카테고리 값 중 이 목록에 있는 첫 값을 사용합니다.
+	Categories []string `json:"categories"`
+}
+
+// NextDataFields는 포스트 필드별 JSONPath 경로 목록입니다.
+type NextDataFields struct {
+	Title       []string `json:"title"`
+	URL         []string `json:"url"`
+	Author      []string `json:"author"`
+	PublishedAt []string `json:"published_at"`
+	Summary     []string `json:"summary"`
+	Category    []string `json:"category"`
+	Image       []string `json:"image"`
+	// Tags는 모든 경로에서 찾은 값을 태그로 사용합니다.
+	Tags []string `json:"tags"`
+}
+
+// PaginationConfig는 목록 페이지의 페이지네이션 설정입니다.
+type PaginationConfig struct {
+	// Pattern은 {page}가 페이지 번호로 치환되는 URL입니다. (예: https://example.com/page/{page})
+	Pattern string `json:"pattern"`
+	// Start는 Pattern의 첫 페이지 번호입니다. 기본값은 2입니다. (1페이지는 ListingURLs)
+	Start    int `json:"start"`
+	MaxPages int `json:"max_pages"`
+	// Next는 다음 페이지 링크의 선택자입니다. Pattern 대신 사용할 수 있습니다.
+	Next string `json:"next"`
+}
+
+// FieldSelectors는 포스트 필드별 선택자입니다.
+type FieldSelectors struct {
+	Title    Selector `json:"title"`
+	URL      Selector `json:"url"`
+	Date     Selector `json:"date"`
+	Summary  Selector `json:"summary"`
+	Image    Selector `json:"image"`
+	Category Selector `json:"category"`
+	Author   Selector `json:"author"`
+}
+
+// Selector는 요소에서 값 하나를 꺼내는 방법입니다. Selector, Attr, Pattern이 모두 비어 있으면 필드를 찾지 않습니다.
+type Selector struct {
+	// Selector는 CSS 선택자입니다. 비어 있으면 기준 요소 자신을 사용합니다.
+	Selector string `json:"selector"`
+	// Attr이 있으면 텍스트 대신 해당 속성 값을 사용합니다.
+	Attr string `json:"attr"`
+	// Pattern이 있으면 정규식과 일치하는 값만 사용하며, 캡처 그룹이 있으면 첫 그룹을 사용합니다.
+	Pattern string `json:"pattern"`
+	// MinLength, MaxLength는 값의 글자 수 범위입니다. 0이면 제한하지 않습니다.
+	MinLength int `json:"min_length"`
+	MaxLength int `json:"max_length"`
+}
+
+// CategoryRule은 카테고리 텍스트에 Match가 포함되면 Category로 분류하는 규칙입니다.
+type CategoryRule struct {
+	Match    []string `json:"match"`
+	Category string   `json:"category"`
+}
+
+// Load는 JSON 설정 파일을 읽습니다.
+// 알림 채널 url과 다이제스트의 발신 주소, SMTP 접속 정보에 포함된 ${ENV} 형식의 환경변수는 파싱 후 치환됩니다.
+// 치환된 값은 JSON으로 다시 해석하지 않으므로 따옴표나 역슬래시가 있는 비밀 값도 그대로 들어가며,
+// 선택자나 정규식처럼 $가 의미를 갖는 다른 필드는 바꾸지 않습니다.
+func Load(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, fmt.Errorf("설정 파일 읽기 실패: %w", err)
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("설정 파일 파싱 실패 (%s): %w", path, err)
+	}
+	cfg.expandEnv()
+	return cfg, nil
+}
+
+// expandEnv는 비밀 값과 접속 주소 필드의 환경변수를 치환합니다.
+func (c *Config) expandEnv() {
+	for i := range c.Notifiers {
+		c.Notifiers[i].URL = os.ExpandEnv(c.Notifiers[i].URL)
+	}
+	c.Digest.From = os.ExpandEnv(c.Digest.From)
+	c.Digest.SMTP.Host = os.ExpandEnv(c.Digest.SMTP.Host)
+	c.Digest.SMTP.Username = os.ExpandEnv(c.Digest.SMTP.Username)
+	c.Digest.SMTP.Password = os.ExpandEnv(c.Digest.SMTP.Password)
+}
@@ -0,0 +1,39 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadExpandsOnlySecretFields(t *testing.T) {
+	t.Setenv("SMTP_PASSWORD", `p"a\ss`)
+	t.Setenv("HOOK_URL", "https://hooks.example.com/abc")
+
+	path := filepath.Join(t.TempDir(), "config.json")
+	data := `{
+  "notifiers": [{"type": "webhook", "url": "${HOOK_URL}"}],
+  "digest": {"smtp": {"password": "${SMTP_PASSWORD}"}},
+  "sources": [{"id": "x", "item_url_pattern": "^/post/$1$", "fields": {"title": {"pattern": "(\\d+)$name"}}}]
+}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.Digest.SMTP.Password; got != `p"a\ss` {
+		t.Errorf("SMTP password = %q", got)
+	}
+	if got := cfg.Notifiers[0].URL; got != "https://hooks.example.com/abc" {
+		t.Errorf("notifier url = %q", got)
+	}
+	if got := cfg.Sources[0].ItemURLPattern; got != "^/post/$1$" {
+		t.Errorf("item_url_pattern가 바뀜: %q", got)
+	}
+	if got := cfg.Sources[0].Fields.Title.Pattern; got != `(\d+)$name` {
+		t.Errorf("title pattern이 바뀜: %q", got)
+	}
+}
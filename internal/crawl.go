@@ -2,18 +2,23 @@ package internal
 
 import (
 	"bytes"
+	"context"
+	"fmt"
 	"log"
+	"slices"
 	"sort"
 	"strings"
-	"sync"
 	"text/template"
 	"time"
 
+	"hello-go/internal/images"
 	"hello-go/internal/models"
 )
 
-// generateHTML은 HTML을 생성하고 S3에 업로드합니다.
-func generateHTML(posts []models.BlogPost, blogStats map[string]int) (string, error) {
+// GenerateHTML은 포스트 목록으로 HTML을 생성합니다. 정렬과 기본 이미지 설정은 복사본에 하므로 posts는 바뀌지 않습니다.
+func GenerateHTML(posts []models.BlogPost, blogStats map[string]int) (string, error) {
+	posts = slices.Clone(posts)
+
 	// 포스트를 최신순으로 정렬 (내림차순)
 	sort.Slice(posts, func(i, j int) bool {
 		return posts[i].PublishedAt.After(posts[j].PublishedAt)
@@ -39,13 +44,11 @@ func generateHTML(posts []models.BlogPost, blogStats map[string]int) (string, er
 	}
 	sort.Strings(blogList)
 
-	// 기본 이미지 (흰색 SVG)
-	defaultImage := `data:image/svg+xml;base64,PHN2ZyB3aWR0aD0iMzAwIiBoZWlnaHQ9IjIwMCIgdmlld0JveD0iMCAwIDMwMCAyMDAiIGZpbGw9Im5vbmUiIHhtbG5zPSJodHRwOi8vd3d3LnczLm9yZy8yMDAwL3N2ZyI+CjxyZWN0IHdpZHRoPSIzMDAiIGhlaWdodD0iMjAwIiBmaWxsPSIjRkZGRkZGIi8+Cjwvc3ZnPg==`
-
-	// 이미지가 없는 포스트에 기본 이미지 설정
+	// 이미지가 없는 포스트에 제목, 소스, 카테고리로 만든 커버 설정
 	for i := range posts {
 		if posts[i].Image == "" {
-			posts[i].Image = defaultImage
+			posts[i].Image = images.PlaceholderURI(posts[i])
+			posts[i].ImageColor = images.PlaceholderColor(posts[i])
 		}
 	}
 
@@ -207,12 +210,12 @@ func generateHTML(posts []models.BlogPost, blogStats map[string]int) (string, er
         }
 
         .post-image {
+            display: block;
             width: 100%;
             height: 200px;
-            background-size: cover;
-            background-position: center;
-            background-repeat: no-repeat;
-            position: relative;
+            object-fit: cover;
+            object-position: center;
+            background-color: #e9ecef;
         }
 
         .post-content {
@@ -252,6 +255,14 @@ func generateHTML(posts []models.BlogPost, blogStats map[string]int) (string, er
             font-weight: 500;
         }
 
+        .post-new {
+            background: #ff6b6b;
+            color: white;
+            padding: 3px 8px;
+            border-radius: 12px;
+            font-weight: 600;
+        }
+
         .post-category {
             background: #f1f3f4;
             color: #666;
@@ -384,12 +395,12 @@ func generateHTML(posts []models.BlogPost, blogStats map[string]int) (string, er
         <div class="posts-grid">
             {{range .Posts}}
             <div class="post-card" data-source="{{.Source}}" data-category="{{.Category}}" onclick="window.open('{{.URL}}', '_blank')">
-                <div class="post-image" style="background-image: url('{{.Image}}')">
-                </div>
+                <picture>{{if .ImageWebP}}<source srcset="{{.ImageWebP}}" type="image/webp">{{end}}<img class="post-image" src="{{.Image}}" alt="" loading="lazy" decoding="async" width="{{if .ImageWidth}}{{.ImageWidth}}{{else}}600{{end}}" height="{{if .ImageHeight}}{{.ImageHeight}}{{else}}400{{end}}"{{if .ImageColor}} style="background-color: {{.ImageColor}}"{{end}}></picture>
                 <div class="post-content">
                     <div class="post-header">
                         <h3 class="post-title">{{.Title}}</h3>
                         <div class="post-meta">
+                            {{if .IsNew}}<span class="post-new">NEW</span>{{end}}
                             <span class="post-source">{{.Source}}</span>
                             <span class="post-category">{{.Category}}</span>
                         </div>
@@ -471,71 +482,54 @@ func generateHTML(posts []models.BlogPost, blogStats map[string]int) (string, er
 	return htmlContent, nil
 }
 
-func Crawl(filterDate string, handler func(html string), blogCrawlers ...models.BlogCrawler) {
-	log.Println("🚀 개발자들의 이야기 모음집 시작")
-	start := time.Now()
+// Options는 크롤링 결과를 수집할 때의 옵션입니다.
+type Options struct {
+	// FilterDate는 이 날짜(YYYY-MM-DD) 이후에 발행된 포스트만 남깁니다.
+	FilterDate string
+	// Until이 설정되면 이 시각 이후에 발행된 포스트는 제외합니다.
+	Until time.Time
+	// Enrich가 설정되면 중복 제거를 거친 포스트마다 호출하여 상세 페이지 정보로 보강합니다.
+	// 보강으로 발행일이 바뀌어 기간을 벗어난 포스트는 제외합니다.
+	Enrich func(post models.BlogPost) models.BlogPost
+}
 
-	// 병렬 크롤링을 위한 구조체
-	type crawlerResult struct {
-		posts []models.BlogPost
-		err   error
-		name  string
+// Collect는 크롤러들을 파이프라인으로 실행하고 날짜 필터링과 중복 제거를 거친 포스트 목록을 반환합니다.
+// 개별 크롤러의 실패는 오류로 반환하지 않고 실행 정보의 소스별 Error에 기록합니다.
+func Collect(opts Options, blogCrawlers ...models.BlogCrawler) ([]models.BlogPost, models.CrawlRun, error) {
+	var posts []models.BlogPost
+	pipeline := Pipeline{
+		Options: opts,
+		Sink: func(post models.BlogPost) error {
+			posts = append(posts, post)
+			return nil
+		},
 	}
-
-	// 결과를 저장할 채널
-	resultChan := make(chan crawlerResult, len(blogCrawlers))
-	var wg sync.WaitGroup
-
-	// 각 크롤러를 병렬로 실행
-	for _, crawler := range blogCrawlers {
-		wg.Add(1)
-		go func(c models.BlogCrawler) {
-			defer wg.Done()
-			source := c.GetSource()
-			log.Printf("📡 %s 블로그 크롤링 시작...", source.Name)
-			posts, err := c.Crawl()
-			resultChan <- crawlerResult{posts: posts, err: err, name: source.Name}
-		}(crawler)
+	run, err := pipeline.Run(context.Background(), blogCrawlers...)
+	if err != nil {
+		return nil, run, err
 	}
+	return posts, run, nil
+}
 
-	// 모든 고루틴이 완료될 때까지 대기
-	go func() {
-		wg.Wait()
-		close(resultChan)
-	}()
-
-	// 결과 수집
-	var allPosts []models.BlogPost
-	for result := range resultChan {
-		if result.err != nil {
-			log.Printf("%s 크롤링 실패: %v", result.name, result.err)
-		} else {
-			allPosts = append(allPosts, result.posts...)
-			log.Printf("✅ %s 크롤링 완료: %d개 포스트", result.name, len(result.posts))
-		}
-	}
+// Finalize는 크롤링된 포스트를 날짜로 필터링하고 제목 기준으로 중복을 제거하며, 실행 정보의 통계를 채웁니다.
+func Finalize(allPosts []models.BlogPost, opts Options, run *models.CrawlRun) ([]models.BlogPost, error) {
+	defer func() { run.FinishedAt = time.Now() }()
 
-	if len(allPosts) == 0 {
-		log.Println("⚠️  크롤링된 포스트가 없습니다.")
-		return
-	}
-
-	// 블로그별 통계 계산
-	blogStats := make(map[string]int)
-	for _, post := range allPosts {
-		blogStats[post.Source]++
-	}
+	run.TotalCount = len(allPosts)
 
 	// 필터 날짜 파싱
-	filterTime, err := time.Parse("2006-01-02", filterDate)
+	filterTime, err := time.Parse("2006-01-02", opts.FilterDate)
 	if err != nil {
-		log.Fatalf("필터 날짜 파싱 실패: %v", err)
+		return nil, fmt.Errorf("필터 날짜 파싱 실패: %w", err)
+	}
+	if len(allPosts) == 0 {
+		return nil, nil
 	}
 
 	// 지정된 날짜 이후의 포스트만 필터링
 	var filteredPosts []models.BlogPost
 	for _, post := range allPosts {
-		if post.PublishedAt.After(filterTime) || post.PublishedAt.Equal(filterTime) {
+		if inWindow(post, filterTime, opts.Until) {
 			filteredPosts = append(filteredPosts, post)
 		}
 	}
@@ -559,28 +553,17 @@ func Crawl(filterDate string, handler func(html string), blogCrawlers ...models.
 	log.Printf("중복 제거 완료: %d개 중복 제거됨 (필터링 후: %d개 -> 중복 제거 후: %d개)",
 		duplicateCount, len(filteredPosts), len(uniquePosts))
 
-	// 중복 제거된 포스트로 통계 재계산
-	blogStats = make(map[string]int)
-	for _, post := range uniquePosts {
-		blogStats[post.Source]++
-	}
+	run.FilteredCount = len(filteredPosts)
+	run.UniqueCount = len(uniquePosts)
 
-	log.Printf("✅ 전체 크롤링 완료: %d개 포스트 (필터링 후: %d개, 중복 제거 후: %d개)",
-		len(allPosts), len(filteredPosts), len(uniquePosts))
-	log.Printf("📅 필터 기준: %s 이후", filterDate)
-	for blog, count := range blogStats {
-		log.Printf("📊 %s: %d개", blog, count)
-	}
+	return uniquePosts, nil
+}
 
-	html, err := generateHTML(uniquePosts, blogStats)
-	if err != nil {
-		log.Fatalf("HTML 생성 실패: %v", err)
+// BlogStats는 포스트 목록으로 블로그별 포스트 수를 계산합니다.
+func BlogStats(posts []models.BlogPost) map[string]int {
+	blogStats := make(map[string]int)
+	for _, post := range posts {
+		blogStats[post.Source]++
 	}
-
-	handler(html)
-
-	duration := time.Since(start)
-	log.Printf("🎉 완료! 총 소요시간: %v", duration)
-	log.Printf("📊 총 포스트 수: %d개", len(allPosts))
-	log.Printf("📁 생성된 파일: index.html")
+	return blogStats
 }
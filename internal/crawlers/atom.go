@@ -0,0 +1,224 @@
+package crawlers
+
+import (
+	"encoding/xml"
+	"fmt"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// AtomFeed는 Atom 피드 문서(RFC 4287)입니다.
+type AtomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   AtomText    `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []AtomLink  `xml:"link"`
+	Entries []AtomEntry `xml:"entry"`
+}
+
+// AtomEntry는 Atom 피드의 항목 하나입니다.
+type AtomEntry struct {
+	ID         string         `xml:"id"`
+	Title      AtomText       `xml:"title"`
+	Links      []AtomLink     `xml:"link"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+	Authors    []AtomPerson   `xml:"author"`
+	Categories []AtomCategory `xml:"category"`
+	Summary    AtomText       `xml:"summary"`
+	Content    AtomText       `xml:"content"`
+}
+
+// AtomLink는 link 요소입니다. rel이 없으면 alternate입니다.
+type AtomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr"`
+}
+
+// AtomText는 type이 text, html, xhtml인 텍스트 요소입니다.
+type AtomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",innerxml"`
+}
+
+// AtomPerson은 author, contributor 요소입니다.
+type AtomPerson struct {
+	Name  string `xml:"name"`
+	Email string `xml:"email"`
+	URI   string `xml:"uri"`
+}
+
+// AtomCategory는 category 요소입니다.
+type AtomCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
+}
+
+// Link는 rel이 일치하는 첫 링크 주소를 반환합니다.
+func (f AtomFeed) Link(rel string) string {
+	return findLink(f.Links, rel)
+}
+
+// Link는 rel이 일치하는 첫 링크 주소를 반환합니다.
+func (e AtomEntry) Link(rel string) string {
+	return findLink(e.Links, rel)
+}
+
+func findLink(links []AtomLink, rel string) string {
+	for _, link := range links {
+		linkRel := link.Rel
+		if linkRel == "" {
+			linkRel = "alternate"
+		}
+		if linkRel == rel {
+			return strings.TrimSpace(link.Href)
+		}
+	}
+	return ""
+}
+
+// Time은 published를, 없으면 updated를 파싱합니다.
+func (e AtomEntry) Time() (time.Time, bool) {
+	for _, value := range []string{e.Published, e.Updated} {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		if t, err := time.Parse(time.RFC3339, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// Author는 첫 작성자 이름을 반환합니다.
+func (e AtomEntry) Author() string {
+	for _, author := range e.Authors {
+		if name := strings.TrimSpace(author.Name); name != "" {
+			return name
+		}
+	}
+	return ""
+}
+
+// Terms는 카테고리 term 목록을 반환합니다.
+func (e AtomEntry) Terms() []string {
+	var terms []string
+	for _, category := range e.Categories {
+		if term := strings.TrimSpace(category.Term); term != "" {
+			terms = append(terms, term)
+		}
+	}
+	return terms
+}
+
+// HTML은 텍스트 요소를 HTML 문자열로 반환합니다.
+// type이 html이면 이스케이프된 내용을 풀고, text면 그대로 반환합니다.
+func (t AtomText) HTML() string {
+	value := strings.TrimSpace(t.Value)
+	if strings.HasPrefix(value, "<![CDATA[") && strings.HasSuffix(value, "]]>") {
+		return value[len("<![CDATA[") : len(value)-len("]]>")]
+	}
+	if t.Type == "xhtml" {
+		return value
+	}
+	// innerxml은 엔티티가 이스케이프된 상태이므로 한 번 디코딩
+	var decoded string
+	if err := xml.Unmarshal([]byte("<v>"+value+"</v>"), &decoded); err == nil {
+		return decoded
+	}
+	return value
+}
+
+// Text는 HTML 태그를 제거한 텍스트를 반환합니다.
+func (t AtomText) Text() string {
+	html := t.HTML()
+	if t.Type == "" || t.Type == "text" {
+		return strings.Join(strings.Fields(html), " ")
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		return strings.Join(strings.Fields(html), " ")
+	}
+	return strings.Join(strings.Fields(doc.Text()), " ")
+}
+
+// FirstImage는 HTML 내용에서 대표 이미지로 쓸 수 있는 첫 이미지의 절대 주소를 반환합니다. 상대 주소는 pageURL 기준으로 해석합니다.
+func (t AtomText) FirstImage(pageURL string) string {
+	if t.Type == "" || t.Type == "text" {
+		return ""
+	}
+	return contentImage(t.HTML(), pageURL)
+}
+
+// fetchAtom은 Atom 피드 하나를 가져와 디코딩합니다.
+func fetchAtom(client *http.Client, feedURL string) (AtomFeed, error) {
+	resp, err := client.Get(feedURL)
+	if err != nil {
+		return AtomFeed{}, fmt.Errorf("Atom 피드 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return AtomFeed{}, fmt.Errorf("Atom 피드 응답 오류: %d", resp.StatusCode)
+	}
+
+	var feed AtomFeed
+	if err := xml.NewDecoder(resp.Body).Decode(&feed); err != nil {
+		return AtomFeed{}, fmt.Errorf("Atom 피드 파싱 실패: %w", err)
+	}
+	return feed, nil
+}
+
+// crawlAtom은 피드를 가져오고 RFC 5005 링크(next, prev-archive)를 따라 이전 페이지를 가져옵니다.
+// since 이전 항목이 나오거나 다음 링크가 없거나 maxPages에 도달하면 멈춥니다. 첫 페이지 외의 실패는 무시합니다.
+func crawlAtom(client *http.Client, feedURL string, since time.Time, maxPages int) ([]AtomEntry, error) {
+	var entries []AtomEntry
+	visited := make(map[string]bool)
+
+	pageURL := feedURL
+	for page := 1; page <= maxPages && pageURL != "" && !visited[pageURL]; page++ {
+		visited[pageURL] = true
+
+		feed, err := fetchAtom(client, pageURL)
+		if err != nil {
+			if page == 1 {
+				return nil, err
+			}
+			log.Printf("Atom 피드 페이지 %d 요청 실패 (%s): %v", page, pageURL, err)
+			break
+		}
+		entries = append(entries, feed.Entries...)
+		log.Printf("Atom 피드 페이지 %d: %d개 항목", page, len(feed.Entries))
+
+		if reachedSince(feed.Entries, since) {
+			break
+		}
+
+		next := feed.Link("next")
+		if next == "" {
+			next = feed.Link("prev-archive")
+		}
+		pageURL = resolveURL(pageURL, next)
+	}
+	return entries, nil
+}
+
+// reachedSince는 since 이전에 발행된 항목이 있는지 확인합니다.
+func reachedSince(entries []AtomEntry, since time.Time) bool {
+	if since.IsZero() {
+		return false
+	}
+	for _, entry := range entries {
+		if t, ok := entry.Time(); ok && t.Before(since) {
+			return true
+		}
+	}
+	return false
+}
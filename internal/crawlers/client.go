@@ -0,0 +1,22 @@
+package crawlers
+
+import (
+	"net/http"
+	"time"
+
+	"hello-go/internal/httpcache"
+	"hello-go/internal/scheduler"
+)
+
+// newHTTPClient는 크롤러가 사용하는 HTTP 클라이언트를 생성합니다.
+// 모든 요청은 프로세스 전체 Scheduler의 동시 요청 제한을 거치며, HTTP 캐시가 설정되어 있으면 source 이름으로 적중률을 집계합니다.
+func newHTTPClient(source string) *http.Client {
+	transport := scheduler.Default().Transport(nil)
+	if cache := httpcache.Default(); cache != nil {
+		transport = cache.Transport(transport, source)
+	}
+	return &http.Client{
+		Timeout:   60 * time.Second,
+		Transport: transport,
+	}
+}
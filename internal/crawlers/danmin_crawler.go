@@ -19,11 +19,19 @@ type DanminCrawler struct {
 	client *http.Client
 }
 
+func init() {
+	Register(Entry{
+		ID:               "danmin",
+		Name:             "단민",
+		Homepage:         "https://www.jeong-min.com",
+		NeedsDetailFetch: true,
+		NewCrawler:       func(Options) models.BlogCrawler { return NewDanminCrawler() },
+	})
+}
+
 func NewDanminCrawler() *DanminCrawler {
 	return &DanminCrawler{
-		client: &http.Client{
-			Timeout: 60 * time.Second,
-		},
+		client: newHTTPClient("단민"),
 	}
 }
 
@@ -215,18 +223,8 @@ func (c *DanminCrawler) crawlPostDetail(url, title string) (models.BlogPost, err
 		post.Summary = "개발자 단민의 기술 블로그 포스트"
 	}
 
-	// 썸네일 이미지 찾기
-	doc.Find("img").Each(func(i int, s *goquery.Selection) {
-		if post.Image == "" {
-			if src, exists := s.Attr("src"); exists {
-				if strings.HasPrefix(src, "http") {
-					post.Image = src
-				} else if strings.HasPrefix(src, "/") {
-					post.Image = "https://www.jeong-min.com" + src
-				}
-			}
-		}
-	})
+	// 썸네일 이미지 찾기 (메타데이터, 본문 첫 이미지 순)
+	post.Image = ParsePage(doc, url).Image
 
 	// 카테고리 정보 찾기 (다양한 HTML 요소에서 시도)
 	post.Category = c.findCategoryFromHTML(doc)
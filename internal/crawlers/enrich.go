@@ -0,0 +1,138 @@
+package crawlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"hello-go/internal/models"
+)
+
+// ProvenanceListing은 목록에서 가져온 값을 그대로 둔 필드의 출처입니다.
+const ProvenanceListing = "listing"
+
+// Enricher는 포스트 상세 페이지의 구조화된 메타데이터(JSON-LD, OpenGraph, meta 태그)로 목록에서 가져온 정보를 보강합니다.
+// 같은 페이지는 한 번만 요청하며, HTTP 캐시가 설정되어 있으면 다음 실행에서 조건부 요청으로 재검증합니다.
+// 요청은 포스트의 소스별 클라이언트로 보내므로 캐시 적중률도 소스별로 집계됩니다.
+type Enricher struct {
+	mu      sync.Mutex
+	clients map[string]*http.Client
+	pages   map[string]*enrichedPage
+}
+
+// enrichedPage는 페이지 하나의 메타데이터이며, 같은 URL을 동시에 요청해도 한 번만 가져옵니다.
+type enrichedPage struct {
+	once sync.Once
+	meta PageMeta
+	err  error
+}
+
+// NewEnricher는 새로운 Enricher 인스턴스를 생성합니다.
+func NewEnricher() *Enricher {
+	return &Enricher{
+		clients: make(map[string]*http.Client),
+		pages:   make(map[string]*enrichedPage),
+	}
+}
+
+// Enrich는 포스트 페이지의 메타데이터로 필드를 채우거나 고친 포스트를 반환합니다. 여러 고루틴에서 동시에 호출할 수 있습니다.
+// 작성자, 발행일, 수정일, 태그는 페이지에 값이 있으면 목록의 값보다 우선하고,
+// 요약은 비어 있거나 JSON-LD/OpenGraph 설명이 있을 때, 이미지는 비어 있을 때만 채웁니다.
+// 필드별 출처는 Provenance에 기록하며, 페이지를 가져오지 못하면 포스트를 그대로 반환합니다.
+func (e *Enricher) Enrich(post models.BlogPost) models.BlogPost {
+	meta, err := e.page(post.Source, post.URL)
+	if err != nil {
+		log.Printf("상세 페이지 보강 실패 (%s): %v", post.URL, err)
+		return post
+	}
+
+	provenance := make(map[string]string)
+	// fill은 페이지 값을 쓰면 페이지의 출처를, 아니면 목록 값이 있을 때 listing을 기록합니다.
+	fill := func(field string, fromPage, hasListing bool) {
+		switch {
+		case fromPage:
+			provenance[field] = meta.Sources[field]
+		case hasListing:
+			provenance[field] = ProvenanceListing
+		}
+	}
+
+	if meta.Author != "" {
+		post.Author = meta.Author
+	}
+	fill("author", meta.Author != "", post.Author != "")
+
+	if !meta.PublishedAt.IsZero() {
+		post.PublishedAt = meta.PublishedAt
+	}
+	fill("published_at", !meta.PublishedAt.IsZero(), !post.PublishedAt.IsZero())
+
+	if !meta.UpdatedAt.IsZero() {
+		post.UpdatedAt = meta.UpdatedAt
+	}
+	fill("updated_at", !meta.UpdatedAt.IsZero(), !post.UpdatedAt.IsZero())
+
+	if len(meta.Tags) > 0 {
+		post.Tags = meta.Tags
+	}
+	fill("tags", len(meta.Tags) > 0, len(post.Tags) > 0)
+
+	source := meta.Sources["summary"]
+	useSummary := meta.Description != "" && (post.Summary == "" || source == MetaJSONLD || source == MetaOG)
+	if useSummary {
+		post.Summary = truncateRunes(meta.Description, 200)
+	}
+	fill("summary", useSummary, post.Summary != "")
+
+	useImage := post.Image == "" && meta.Image != ""
+	if useImage {
+		post.Image = meta.Image
+	}
+	fill("image", useImage, post.Image != "")
+
+	post.Provenance = provenance
+	return post
+}
+
+// page는 URL의 메타데이터를 반환합니다. 처음 요청한 URL만 source의 클라이언트로 페이지를 가져옵니다.
+func (e *Enricher) page(source, pageURL string) (PageMeta, error) {
+	e.mu.Lock()
+	page, ok := e.pages[pageURL]
+	if !ok {
+		page = &enrichedPage{}
+		e.pages[pageURL] = page
+	}
+	client, ok := e.clients[source]
+	if !ok {
+		client = newHTTPClient(source)
+		e.clients[source] = client
+	}
+	e.mu.Unlock()
+
+	page.once.Do(func() {
+		page.meta, page.err = fetchPage(client, pageURL)
+	})
+	return page.meta, page.err
+}
+
+// fetchPage는 페이지를 가져와 메타데이터를 파싱합니다.
+func fetchPage(client *http.Client, pageURL string) (PageMeta, error) {
+	resp, err := client.Get(pageURL)
+	if err != nil {
+		return PageMeta{}, fmt.Errorf("상세 페이지 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return PageMeta{}, fmt.Errorf("상세 페이지 응답 오류: %d", resp.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return PageMeta{}, fmt.Errorf("상세 페이지 HTML 파싱 실패: %w", err)
+	}
+	return ParsePage(doc, pageURL), nil
+}
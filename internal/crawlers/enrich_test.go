@@ -0,0 +1,179 @@
+package crawlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"hello-go/internal/httpcache"
+	"hello-go/internal/models"
+	"hello-go/internal/storage"
+)
+
+const articlePage = `<html><head>
+<meta property="og:image" content="/images/og.png">
+<meta name="description" content="meta 설명">
+<meta property="og:description" content="  OpenGraph   설명 ">
+<script type="application/ld+json">{"@context": "https://schema.org", "@graph": [
+  {"@type": "WebSite", "name": "블로그"},
+  {"@type": ["BlogPosting"], "author": [{"name": "김개발"}, {"name": "이개발"}],
+   "datePublished": "2025-04-01T09:00:00+09:00", "dateModified": "2025-04-03T10:00:00+09:00",
+   "keywords": "Go, 프로파일링"}
+]}</script>
+</head><body><article><img src="/images/body.png"></article></body></html>`
+
+// newArticleServer는 /article/ 아래 경로에 articlePage를, /plain/에 메타데이터 없는 페이지를 응답합니다.
+func newArticleServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/article/", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("ETag", `"`+r.URL.Path+`"`)
+		if r.Header.Get("If-None-Match") == `"`+r.URL.Path+`"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(w, articlePage)
+	})
+	mux.HandleFunc("/plain/", func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		fmt.Fprint(w, `<html><head><meta name="description" content="meta 설명"></head></html>`)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestEnrichPrefersPageMetadata(t *testing.T) {
+	server, _ := newArticleServer(t)
+	post := NewEnricher().Enrich(models.BlogPost{
+		Title:       "프로파일링",
+		URL:         server.URL + "/article/1",
+		Source:      "픽스처",
+		Author:      "목록 작성자",
+		Summary:     "목록 요약",
+		PublishedAt: time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC),
+	})
+
+	if post.Author != "김개발, 이개발" {
+		t.Errorf("Author = %q", post.Author)
+	}
+	// 페이지의 발행일은 목록의 값보다 우선
+	if !post.PublishedAt.Equal(time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("PublishedAt = %v", post.PublishedAt)
+	}
+	if !post.UpdatedAt.Equal(time.Date(2025, 4, 3, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("UpdatedAt = %v", post.UpdatedAt)
+	}
+	if fmt.Sprint(post.Tags) != "[Go 프로파일링]" {
+		t.Errorf("Tags = %v", post.Tags)
+	}
+	// OpenGraph 설명은 목록 요약보다 우선
+	if post.Summary != "OpenGraph 설명" {
+		t.Errorf("Summary = %q", post.Summary)
+	}
+	if post.Image != server.URL+"/images/og.png" {
+		t.Errorf("Image = %q", post.Image)
+	}
+
+	want := map[string]string{
+		"author":       MetaJSONLD,
+		"published_at": MetaJSONLD,
+		"updated_at":   MetaJSONLD,
+		"tags":         MetaJSONLD,
+		"summary":      MetaOG,
+		"image":        MetaOG,
+	}
+	if fmt.Sprint(post.Provenance) != fmt.Sprint(want) {
+		t.Errorf("Provenance = %v, want %v", post.Provenance, want)
+	}
+}
+
+func TestEnrichKeepsListingValues(t *testing.T) {
+	server, _ := newArticleServer(t)
+	post := NewEnricher().Enrich(models.BlogPost{
+		URL:     server.URL + "/plain/1",
+		Source:  "픽스처",
+		Author:  "목록 작성자",
+		Summary: "목록 요약",
+		Image:   "https://cdn.example.com/listing.png",
+	})
+
+	// meta description은 목록 요약을 덮어쓰지 않고, 이미지는 비어 있을 때만 채움
+	if post.Author != "목록 작성자" || post.Summary != "목록 요약" || post.Image != "https://cdn.example.com/listing.png" {
+		t.Errorf("포스트 = %+v", post)
+	}
+	want := map[string]string{"author": ProvenanceListing, "summary": ProvenanceListing, "image": ProvenanceListing}
+	if fmt.Sprint(post.Provenance) != fmt.Sprint(want) {
+		t.Errorf("Provenance = %v, want %v", post.Provenance, want)
+	}
+
+	// 요약이 비어 있으면 meta description으로 채움
+	empty := NewEnricher().Enrich(models.BlogPost{URL: server.URL + "/plain/2", Source: "픽스처"})
+	if empty.Summary != "meta 설명" || empty.Provenance["summary"] != MetaTag {
+		t.Errorf("빈 요약: Summary = %q, Provenance = %v", empty.Summary, empty.Provenance)
+	}
+}
+
+func TestEnrichFetchesEachPageOnce(t *testing.T) {
+	server, requests := newArticleServer(t)
+	enricher := NewEnricher()
+
+	post := models.BlogPost{URL: server.URL + "/article/1", Source: "픽스처"}
+	done := make(chan models.BlogPost)
+	for range 5 {
+		go func() { done <- enricher.Enrich(post) }()
+	}
+	for range 5 {
+		if enriched := <-done; enriched.Author != "김개발, 이개발" {
+			t.Errorf("Author = %q", enriched.Author)
+		}
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("요청 %d번, want 1", n)
+	}
+}
+
+func TestEnrichReturnsPostOnFailure(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	post := models.BlogPost{URL: server.URL + "/missing", Source: "픽스처", Summary: "목록 요약"}
+	if got := NewEnricher().Enrich(post); got.Summary != "목록 요약" || got.Provenance != nil {
+		t.Errorf("실패한 보강 = %+v", got)
+	}
+}
+
+func TestEnrichCountsCacheHitsPerSource(t *testing.T) {
+	httpcache.Configure(storage.NewFileStorage(t.TempDir()))
+	t.Cleanup(func() { httpcache.Configure(nil) })
+	server, _ := newArticleServer(t)
+
+	posts := []models.BlogPost{
+		{URL: server.URL + "/article/toss", Source: "토스"},
+		{URL: server.URL + "/article/kakao", Source: "카카오"},
+	}
+	for _, post := range posts {
+		NewEnricher().Enrich(post)
+	}
+
+	// 다음 실행에서는 조건부 요청이 적중하며, 포스트의 소스별로 집계
+	window := httpcache.Default().Measure()
+	for _, post := range posts {
+		NewEnricher().Enrich(post)
+	}
+	stats := window.Stop()
+
+	if len(stats) != 2 {
+		t.Fatalf("stats = %+v", stats)
+	}
+	for i, source := range []string{"카카오", "토스"} {
+		if stats[i].Source != source || stats[i].Requests != 1 || stats[i].Hits != 1 {
+			t.Errorf("stats[%d] = %+v, want %s 1/1", i, stats[i], source)
+		}
+	}
+}
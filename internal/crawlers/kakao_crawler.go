@@ -0,0 +1,245 @@
+package crawlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"hello-go/internal/config"
+	"hello-go/internal/jsonpath"
+	"hello-go/internal/models"
+)
+
+// KakaoCrawler는 카카오 기술 블로그를 크롤링합니다.
+type KakaoCrawler struct {
+	client  *http.Client
+	since   time.Time
+	apiURL  string
+	siteURL string
+}
+
+const (
+	// kakaoAPIURL은 tech.kakao.com이 글 목록을 가져오는 JSON 엔드포인트입니다.
+	kakaoAPIURL = "https://tech.kakao.com/api/v1/posts/no-offset"
+	// kakaoSiteURL은 카카오 기술 블로그 사이트 주소입니다.
+	kakaoSiteURL = "https://tech.kakao.com"
+	// kakaoPageSize는 API에 요청하는 페이지당 포스트 수입니다.
+	kakaoPageSize = 20
+	// kakaoMaxPages는 전체 페이지 수를 알 수 없을 때의 최대 페이지 수입니다.
+	kakaoMaxPages = 30
+)
+
+// kakaoPosts는 API 응답과 목록 페이지의 Next.js 데이터에서 포스트를 추출합니다. 둘 다 같은 포스트 객체를 사용합니다.
+var kakaoPosts = MustNextDataExtractor(config.NextDataConfig{
+	Posts: "$..contents[*]",
+	Fields: config.NextDataFields{
+		Title:       []string{"title"},
+		URL:         []string{"id", "seq"},
+		Author:      []string{"author.name", "authors[*].name", "writer.name"},
+		PublishedAt: []string{"releaseDateTime", "releaseDate", "createdAt"},
+		Summary:     []string{"summary", "description"},
+		Category:    []string{"categoryName", "category.name"},
+		Image:       []string{"thumbnailUri", "thumbnailUrl", "thumbnail"},
+		Tags:        []string{"tags[*].name", "tags[*]"},
+	},
+	URLTemplate: "/posts/{value}",
+})
+
+// kakaoTotalPages는 응답에서 전체 페이지 수를 찾는 경로입니다.
+var kakaoTotalPages = []*jsonpath.Path{
+	jsonpath.MustCompile("$..totalPage"),
+	jsonpath.MustCompile("$..totalPages"),
+}
+
+func init() {
+	Register(Entry{
+		ID:                 "kakao",
+		Name:               "카카오",
+		Homepage:           kakaoSiteURL + "/blog",
+		SupportsSince:      true,
+		SupportsPagination: true,
+		NewCrawler:         func(opts Options) models.BlogCrawler { return NewKakaoCrawler(opts.Since) },
+	})
+}
+
+// NewKakaoCrawler는 새로운 KakaoCrawler 인스턴스를 생성합니다. since 이전 포스트가 나오면 페이지네이션을 멈춥니다.
+func NewKakaoCrawler(since time.Time) *KakaoCrawler {
+	return &KakaoCrawler{
+		client:  newHTTPClient("카카오"),
+		since:   since,
+		apiURL:  kakaoAPIURL,
+		siteURL: kakaoSiteURL,
+	}
+}
+
+// NewKakaoCrawlerWithURLs는 API와 사이트 주소를 지정하여 KakaoCrawler를 생성합니다. 테스트 서버에 사용합니다.
+func NewKakaoCrawlerWithURLs(since time.Time, apiURL, siteURL string) *KakaoCrawler {
+	c := NewKakaoCrawler(since)
+	c.apiURL = apiURL
+	c.siteURL = strings.TrimSuffix(siteURL, "/")
+	return c
+}
+
+// GetSource는 카카오 블로그 소스 정보를 반환합니다.
+func (c *KakaoCrawler) GetSource() models.BlogSource {
+	return models.BlogSource{
+		Name: "카카오",
+		URL:  "https://tech.kakao.com/blog",
+	}
+}
+
+// Crawl은 카카오 기술 블로그를 크롤링합니다. 중간 페이지가 실패하면 그 전 페이지까지의 포스트와 오류를 함께 반환합니다.
+func (c *KakaoCrawler) Crawl() ([]models.BlogPost, error) {
+	var posts []models.BlogPost
+	for post, err := range c.Stream() {
+		if err != nil {
+			return posts, err
+		}
+		posts = append(posts, post)
+	}
+	log.Printf("카카오 블로그 크롤링 완료: 총 %d개 포스트 발견", len(posts))
+	return posts, nil
+}
+
+// Stream은 1페이지부터 순서대로 가져오며 페이지마다 포스트를 내보냅니다.
+// JSON API를 우선 사용하고, 첫 API 요청이 실패하면 목록 페이지의 Next.js 데이터를 사용합니다.
+// since 이전 포스트가 있거나, 비어 있거나, 마지막 페이지에 도달하면 멈추며, 페이지 요청이 실패하면 오류를 내보내고 멈춥니다.
+func (c *KakaoCrawler) Stream() iter.Seq2[models.BlogPost, error] {
+	return func(yield func(models.BlogPost, error) bool) {
+		log.Printf("카카오 블로그 크롤링 시작")
+
+		fetch := c.fetchAPIPage
+		posts, totalPages, err := fetch(1)
+		if err != nil {
+			log.Printf("카카오 API 크롤링 실패, 목록 페이지로 전환: %v", err)
+			fetch = c.fetchListingPage
+			if posts, totalPages, err = fetch(1); err != nil {
+				yield(models.BlogPost{}, err)
+				return
+			}
+		}
+
+		for page := 1; ; page++ {
+			if page > 1 {
+				if posts, totalPages, err = fetch(page); err != nil {
+					yield(models.BlogPost{}, fmt.Errorf("카카오 페이지 %d 요청 실패: %w", page, err))
+					return
+				}
+			}
+			log.Printf("카카오 페이지 %d: %d개 포스트", page, len(posts))
+			for _, post := range posts {
+				if !yield(post, nil) {
+					return
+				}
+			}
+
+			if len(posts) == 0 || c.reachedSince(posts) || (totalPages > 0 && page >= totalPages) || page >= kakaoMaxPages {
+				return
+			}
+		}
+	}
+}
+
+// reachedSince는 since 이전에 발행된 포스트가 있는지 확인합니다.
+func (c *KakaoCrawler) reachedSince(posts []models.BlogPost) bool {
+	if c.since.IsZero() {
+		return false
+	}
+	for _, post := range posts {
+		if post.PublishedAt.Before(c.since) {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchAPIPage는 API에서 페이지 하나를 가져와 포스트와 전체 페이지 수를 반환합니다.
+func (c *KakaoCrawler) fetchAPIPage(page int) ([]models.BlogPost, int, error) {
+	url := fmt.Sprintf("%s?categoryCode=blog&page=%d&pageSize=%d", c.apiURL, page, kakaoPageSize)
+	resp, err := c.client.Get(url)
+	if err != nil {
+		return nil, 0, fmt.Errorf("카카오 API 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("카카오 API 응답 오류: %d", resp.StatusCode)
+	}
+
+	var data any
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, 0, fmt.Errorf("카카오 API 응답 파싱 실패: %w", err)
+	}
+	if _, ok := data.(map[string]any); !ok {
+		return nil, 0, fmt.Errorf("카카오 API 응답이 객체가 아닙니다")
+	}
+
+	totalPages := 0
+	for _, p := range kakaoTotalPages {
+		if v, ok := p.First(data); ok {
+			totalPages, _ = strconv.Atoi(jsonpath.String(v))
+			break
+		}
+	}
+	return c.complete(kakaoPosts.Map(data, c.siteURL)), totalPages, nil
+}
+
+// fetchListingPage는 목록 페이지 HTML의 Next.js 데이터에서 포스트를 가져옵니다. 전체 페이지 수는 알 수 없으므로 0을 반환합니다.
+func (c *KakaoCrawler) fetchListingPage(page int) ([]models.BlogPost, int, error) {
+	pageURL := fmt.Sprintf("%s/blog?page=%d", c.siteURL, page)
+	resp, err := c.client.Get(pageURL)
+	if err != nil {
+		return nil, 0, fmt.Errorf("카카오 목록 페이지 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, 0, fmt.Errorf("카카오 목록 페이지 응답 오류: %d", resp.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("카카오 목록 페이지 파싱 실패: %w", err)
+	}
+
+	posts, err := kakaoPosts.Extract(doc, c.siteURL)
+	if err != nil {
+		return nil, 0, err
+	}
+	return c.complete(posts), 0, nil
+}
+
+// complete는 날짜가 없는 포스트를 제외하고 비어 있는 필드를 기본값으로 채웁니다.
+// 카테고리가 없으면 첫 태그를 사용합니다.
+func (c *KakaoCrawler) complete(posts []models.BlogPost) []models.BlogPost {
+	result := make([]models.BlogPost, 0, len(posts))
+	for _, post := range posts {
+		if post.PublishedAt.IsZero() {
+			log.Printf("카카오 포스트 날짜 없음, 제외: %s", post.Title)
+			continue
+		}
+
+		post.Source = "카카오"
+		if post.Author == "" {
+			post.Author = "카카오"
+		}
+		if post.Summary == "" {
+			post.Summary = "카카오 기술 블로그 포스트"
+		}
+		if post.Category == "" && len(post.Tags) > 0 {
+			post.Category = post.Tags[0]
+		}
+		if post.Category == "" {
+			post.Category = "개발"
+		}
+		result = append(result, post)
+	}
+	return result
+}
@@ -0,0 +1,121 @@
+package crawlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// kakaoFixture는 페이지마다 kakaoPageSize개의 포스트를 돌려주는 카카오 API 테스트 서버입니다.
+type kakaoFixture struct {
+	totalPages int
+	// oldFrom은 이 페이지부터 포스트 발행일이 since 이전입니다. 0이면 모두 최근 포스트입니다.
+	oldFrom int
+	// failPage는 500으로 응답하는 페이지입니다.
+	failPage int
+
+	mu        sync.Mutex
+	requested []int
+}
+
+func (f *kakaoFixture) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	f.mu.Lock()
+	f.requested = append(f.requested, page)
+	f.mu.Unlock()
+
+	if page == f.failPage {
+		http.Error(w, "boom", http.StatusInternalServerError)
+		return
+	}
+
+	released := "2025-06-01T10:00:00+09:00"
+	if f.oldFrom > 0 && page >= f.oldFrom {
+		released = "2024-01-01T10:00:00+09:00"
+	}
+	contents := make([]map[string]any, kakaoPageSize)
+	for i := range contents {
+		id := (page-1)*kakaoPageSize + i
+		contents[i] = map[string]any{
+			"id":              id,
+			"title":           fmt.Sprintf("포스트 %d", id),
+			"releaseDateTime": released,
+			"author":          map[string]any{"name": "라이언"},
+			"tags":            []map[string]any{{"name": "AI"}},
+			"thumbnailUri":    fmt.Sprintf("https://t1.kakaocdn.net/%d.png", id),
+		}
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"data": map[string]any{"contents": contents, "totalPage": f.totalPages},
+	})
+}
+
+func newKakaoFixture(t *testing.T, f *kakaoFixture) *KakaoCrawler {
+	t.Helper()
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	return NewKakaoCrawlerWithURLs(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), server.URL, server.URL)
+}
+
+func TestKakaoPaginatesToTotalPages(t *testing.T) {
+	fixture := &kakaoFixture{totalPages: 3}
+	crawler := newKakaoFixture(t, fixture)
+
+	posts, err := crawler.Crawl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 3*kakaoPageSize {
+		t.Errorf("포스트 %d개, want %d", len(posts), 3*kakaoPageSize)
+	}
+	if fmt.Sprint(fixture.requested) != "[1 2 3]" {
+		t.Errorf("요청한 페이지 %v, want [1 2 3]", fixture.requested)
+	}
+
+	post := posts[kakaoPageSize]
+	if post.Title != "포스트 20" || post.URL != crawler.siteURL+"/posts/20" || post.Author != "라이언" || post.Category != "AI" {
+		t.Errorf("포스트 = %+v", post)
+	}
+}
+
+func TestKakaoStopsAtSince(t *testing.T) {
+	fixture := &kakaoFixture{totalPages: 10, oldFrom: 2}
+	posts, err := newKakaoFixture(t, fixture).Crawl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 2*kakaoPageSize || len(fixture.requested) != 2 {
+		t.Errorf("포스트 %d개, 요청한 페이지 %v", len(posts), fixture.requested)
+	}
+}
+
+func TestKakaoStreamYieldsLaterPageError(t *testing.T) {
+	fixture := &kakaoFixture{totalPages: 5, failPage: 3}
+	crawler := newKakaoFixture(t, fixture)
+
+	var count int
+	var streamErr error
+	for _, err := range crawler.Stream() {
+		if err != nil {
+			streamErr = err
+			break
+		}
+		count++
+	}
+	if streamErr == nil {
+		t.Fatal("3페이지 실패가 오류로 전달되지 않음")
+	}
+	if count != 2*kakaoPageSize {
+		t.Errorf("실패 전 포스트 %d개, want %d", count, 2*kakaoPageSize)
+	}
+
+	posts, err := crawler.Crawl()
+	if err == nil || len(posts) != 2*kakaoPageSize {
+		t.Errorf("Crawl() = %d개, %v", len(posts), err)
+	}
+}
@@ -0,0 +1,434 @@
+package crawlers
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"hello-go/internal/models"
+)
+
+// defaultMediumURL은 Medium 기본 주소입니다.
+const defaultMediumURL = "https://medium.com"
+
+// mediumImageURL은 Medium 이미지 ID로 커버 이미지 주소를 만드는 접두사입니다.
+const mediumImageURL = "https://miro.medium.com/v2/resize:fit:1200/"
+
+// maxArchiveMonths는 since가 없을 때 아카이브를 거슬러 올라가는 최대 개월 수입니다.
+const maxArchiveMonths = 24
+
+// ErrNoApolloState는 아카이브 페이지에 Apollo 상태가 없을 때 반환됩니다.
+var ErrNoApolloState = errors.New("Apollo 상태를 찾을 수 없습니다")
+
+// MediumPublication은 Medium에 호스팅된 블로그(publication) 설정입니다.
+type MediumPublication struct {
+	// Slug는 publication 주소의 경로입니다. (예: medium.com/daangn의 daangn)
+	Slug string
+	// Name은 포스트의 Source로 쓰는 블로그 이름입니다.
+	Name           string
+	DefaultAuthor  string
+	DefaultSummary string
+	// Categorize가 있으면 포스트의 카테고리를 결정합니다. 없으면 첫 태그를, 태그가 없으면 DefaultCategory를 사용합니다.
+	Categorize      func(post models.BlogPost) string
+	DefaultCategory string
+}
+
+// MediumCrawler는 Medium publication을 RSS 피드와 월별 아카이브 페이지로 크롤링합니다.
+// RSS 피드는 최근 10개 정도만 제공하므로, 아카이브 페이지의 Apollo 상태로 since까지 거슬러 올라갑니다.
+type MediumCrawler struct {
+	client  *http.Client
+	pub     MediumPublication
+	since   time.Time
+	baseURL string
+}
+
+// NewMediumCrawler는 새로운 MediumCrawler 인스턴스를 생성합니다.
+func NewMediumCrawler(pub MediumPublication, since time.Time) *MediumCrawler {
+	return NewMediumCrawlerWithURL(pub, since, defaultMediumURL)
+}
+
+// NewMediumCrawlerWithURL은 Medium 주소를 지정하여 MediumCrawler를 생성합니다.
+func NewMediumCrawlerWithURL(pub MediumPublication, since time.Time, baseURL string) *MediumCrawler {
+	return &MediumCrawler{
+		client:  newHTTPClient(pub.Name),
+		pub:     pub,
+		since:   since,
+		baseURL: strings.TrimRight(baseURL, "/"),
+	}
+}
+
+// GetSource는 블로그 소스 정보를 반환합니다.
+func (c *MediumCrawler) GetSource() models.BlogSource {
+	return models.BlogSource{
+		Name: c.pub.Name,
+		URL:  c.homepage(),
+	}
+}
+
+// Crawl은 RSS 피드와 아카이브 페이지를 크롤링하여 병합합니다.
+// 피드와 아카이브 중 하나만 성공해도 결과를 반환합니다.
+func (c *MediumCrawler) Crawl() ([]models.BlogPost, error) {
+	feedPosts, feedErr := c.crawlFeed()
+	if feedErr != nil {
+		log.Printf("%s RSS 피드 크롤링 실패: %v", c.pub.Name, feedErr)
+	} else {
+		log.Printf("%s RSS 피드에서 %d개 포스트 가져옴", c.pub.Name, len(feedPosts))
+	}
+
+	archivePosts, archiveErr := c.crawlArchive()
+	if archiveErr != nil {
+		log.Printf("%s 아카이브 크롤링 실패: %v", c.pub.Name, archiveErr)
+	} else {
+		log.Printf("%s 아카이브에서 %d개 포스트 가져옴", c.pub.Name, len(archivePosts))
+	}
+
+	if feedErr != nil && archiveErr != nil {
+		return nil, fmt.Errorf("%s 크롤링 실패: %w", c.pub.Name, feedErr)
+	}
+
+	// 병합한 뒤에 기본값을 채워야 피드에 없는 작성자와 요약을 아카이브 값으로 채울 수 있음
+	posts := mergeMediumPosts(feedPosts, archivePosts)
+	for i := range posts {
+		posts[i] = c.complete(posts[i])
+		posts[i].Category = c.categorize(posts[i])
+	}
+	sort.SliceStable(posts, func(i, j int) bool {
+		return posts[i].PublishedAt.After(posts[j].PublishedAt)
+	})
+
+	log.Printf("%s 크롤링 완료: 총 %d개 포스트 발견", c.pub.Name, len(posts))
+	return posts, nil
+}
+
+// homepage는 publication 주소를 반환합니다.
+func (c *MediumCrawler) homepage() string {
+	return c.baseURL + "/" + c.pub.Slug
+}
+
+// mediumRSS는 Medium RSS 피드 문서입니다.
+type mediumRSS struct {
+	Items []mediumItem `xml:"channel>item"`
+}
+
+// mediumItem은 Medium RSS 피드의 항목 하나입니다.
+type mediumItem struct {
+	Title      string   `xml:"title"`
+	Link       string   `xml:"link"`
+	Categories []string `xml:"category"`
+	Creator    string   `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	PubDate    string   `xml:"pubDate"`
+	Content    string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+}
+
+// crawlFeed는 publication RSS 피드를 크롤링합니다.
+func (c *MediumCrawler) crawlFeed() ([]models.BlogPost, error) {
+	feedURL := c.baseURL + "/feed/" + c.pub.Slug
+	resp, err := c.client.Get(feedURL)
+	if err != nil {
+		return nil, fmt.Errorf("RSS 피드 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("RSS 피드 응답 오류: %d", resp.StatusCode)
+	}
+
+	var rss mediumRSS
+	if err := xml.NewDecoder(resp.Body).Decode(&rss); err != nil {
+		return nil, fmt.Errorf("RSS 피드 파싱 실패: %w", err)
+	}
+
+	var posts []models.BlogPost
+	for _, item := range rss.Items {
+		title := strings.TrimSpace(item.Title)
+		link := strings.TrimSpace(item.Link)
+		if title == "" || link == "" {
+			continue
+		}
+
+		publishedAt, err := time.Parse(time.RFC1123, strings.TrimSpace(item.PubDate))
+		if err != nil {
+			log.Printf("RSS 날짜 파싱 실패 (%s): %v", title, err)
+			continue
+		}
+
+		content := AtomText{Type: "html", Value: item.Content}
+		post := models.BlogPost{
+			Title:       title,
+			URL:         link,
+			Author:      strings.TrimSpace(item.Creator),
+			PublishedAt: publishedAt,
+			Summary:     truncateRunes(content.Text(), 200),
+			Source:      c.pub.Name,
+			Image:       content.FirstImage(link),
+			Tags:        mediumTags(item.Categories),
+		}
+		posts = append(posts, post)
+	}
+	return posts, nil
+}
+
+// crawlArchive는 이번 달부터 since가 속한 달까지 월별 아카이브 페이지를 크롤링합니다.
+// 첫 페이지가 실패하면 오류를 반환하고, 이후 페이지의 실패는 건너뜁니다.
+func (c *MediumCrawler) crawlArchive() ([]models.BlogPost, error) {
+	now := time.Now()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	oldest := month.AddDate(0, -maxArchiveMonths+1, 0)
+	if !c.since.IsZero() {
+		oldest = time.Date(c.since.Year(), c.since.Month(), 1, 0, 0, 0, 0, time.UTC)
+	}
+
+	var posts []models.BlogPost
+	for first := true; !month.Before(oldest); month, first = month.AddDate(0, -1, 0), false {
+		pageURL := fmt.Sprintf("%s/archive/%04d/%02d", c.homepage(), month.Year(), int(month.Month()))
+		monthPosts, err := c.fetchArchive(pageURL)
+		if err != nil {
+			if first {
+				return nil, err
+			}
+			log.Printf("아카이브 페이지 요청 실패 (%s): %v", pageURL, err)
+			continue
+		}
+		log.Printf("아카이브 %s: %d개 포스트", month.Format("2006-01"), len(monthPosts))
+		posts = append(posts, monthPosts...)
+	}
+	return posts, nil
+}
+
+// fetchArchive는 아카이브 페이지 하나를 가져와 Apollo 상태에서 포스트를 추출합니다.
+func (c *MediumCrawler) fetchArchive(pageURL string) ([]models.BlogPost, error) {
+	resp, err := c.client.Get(pageURL)
+	if err != nil {
+		return nil, fmt.Errorf("아카이브 페이지 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("아카이브 페이지 응답 오류: %d", resp.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("아카이브 HTML 파싱 실패: %w", err)
+	}
+
+	state, err := apolloState(doc)
+	if err != nil {
+		return nil, err
+	}
+	return c.apolloPosts(state), nil
+}
+
+// apolloState는 window.__APOLLO_STATE__ 스크립트의 JSON을 디코딩합니다.
+func apolloState(doc *goquery.Document) (map[string]any, error) {
+	const marker = "window.__APOLLO_STATE__"
+
+	var state map[string]any
+	var decodeErr error
+	doc.Find("script").EachWithBreak(func(i int, s *goquery.Selection) bool {
+		text := s.Text()
+		idx := strings.Index(text, marker)
+		if idx < 0 {
+			return true
+		}
+		start := strings.Index(text[idx:], "{")
+		if start < 0 {
+			return true
+		}
+		// 객체 뒤에 세미콜론 등이 있어도 첫 JSON 값만 디코딩
+		decodeErr = json.NewDecoder(strings.NewReader(text[idx+start:])).Decode(&state)
+		return false
+	})
+
+	if decodeErr != nil {
+		return nil, fmt.Errorf("Apollo 상태 파싱 실패: %w", decodeErr)
+	}
+	if state == nil {
+		return nil, ErrNoApolloState
+	}
+	return state, nil
+}
+
+// apolloPosts는 Apollo 상태의 Post 객체 중 이 publication의 공개 포스트를 변환합니다.
+func (c *MediumCrawler) apolloPosts(state map[string]any) []models.BlogPost {
+	var posts []models.BlogPost
+	for key, value := range state {
+		if !strings.HasPrefix(key, "Post:") {
+			continue
+		}
+		obj, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		// 추천 글 등 다른 publication의 포스트는 제외
+		if collection := apolloRef(state, apolloField(obj, "collection")); collection != nil {
+			if slug, _ := collection["slug"].(string); slug != "" && slug != c.pub.Slug {
+				continue
+			}
+		}
+
+		title, _ := apolloField(obj, "title").(string)
+		postURL, _ := apolloField(obj, "mediumUrl").(string)
+		publishedMs, _ := apolloField(obj, "firstPublishedAt").(float64)
+		if strings.TrimSpace(title) == "" || postURL == "" || publishedMs == 0 {
+			continue
+		}
+
+		post := models.BlogPost{
+			Title:       strings.TrimSpace(title),
+			URL:         postURL,
+			PublishedAt: time.UnixMilli(int64(publishedMs)),
+			Source:      c.pub.Name,
+		}
+		if creator := apolloRef(state, apolloField(obj, "creator")); creator != nil {
+			post.Author, _ = creator["name"].(string)
+		}
+		if preview := apolloRef(state, apolloField(obj, "extendedPreviewContent")); preview != nil {
+			post.Summary, _ = preview["subtitle"].(string)
+		}
+		if image := apolloRef(state, apolloField(obj, "previewImage")); image != nil {
+			if id, _ := image["id"].(string); id != "" {
+				post.Image = mediumImageURL + id
+			}
+		}
+		if tags, ok := apolloField(obj, "tags").([]any); ok {
+			var names []string
+			for _, tag := range tags {
+				tagObj := apolloRef(state, tag)
+				if tagObj == nil {
+					continue
+				}
+				name, _ := tagObj["displayTitle"].(string)
+				if name == "" {
+					name, _ = tagObj["id"].(string)
+				}
+				names = append(names, name)
+			}
+			post.Tags = mediumTags(names)
+		}
+		posts = append(posts, post)
+	}
+	return posts
+}
+
+// apolloField는 객체의 필드 값을 반환합니다. 인자가 붙은 필드(예: previewImage({...}))도 찾습니다.
+func apolloField(obj map[string]any, name string) any {
+	if value, ok := obj[name]; ok {
+		return value
+	}
+	for key, value := range obj {
+		if strings.HasPrefix(key, name+"(") {
+			return value
+		}
+	}
+	return nil
+}
+
+// apolloRef는 {"__ref": "Type:id"} 참조를 상태의 객체로 바꿉니다. 참조가 아닌 객체는 그대로 반환합니다.
+func apolloRef(state map[string]any, value any) map[string]any {
+	obj, ok := value.(map[string]any)
+	if !ok {
+		return nil
+	}
+	if ref, ok := obj["__ref"].(string); ok {
+		resolved, _ := state[ref].(map[string]any)
+		return resolved
+	}
+	return obj
+}
+
+// complete는 비어 있는 작성자와 요약을 기본값으로 채웁니다.
+func (c *MediumCrawler) complete(post models.BlogPost) models.BlogPost {
+	post.Author = strings.TrimSpace(post.Author)
+	if post.Author == "" {
+		post.Author = c.pub.DefaultAuthor
+	}
+	post.Summary = strings.TrimSpace(post.Summary)
+	if post.Summary == "" {
+		post.Summary = c.pub.DefaultSummary
+	}
+	return post
+}
+
+// categorize는 포스트의 카테고리를 결정합니다.
+func (c *MediumCrawler) categorize(post models.BlogPost) string {
+	if c.pub.Categorize != nil {
+		return c.pub.Categorize(post)
+	}
+	if len(post.Tags) > 0 {
+		return post.Tags[0]
+	}
+	return c.pub.DefaultCategory
+}
+
+// mediumTags는 태그의 공백을 정리하고 빈 값과 중복을 제거합니다.
+func mediumTags(tags []string) []string {
+	var result []string
+	seen := make(map[string]bool)
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		key := strings.ToLower(tag)
+		if tag == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, tag)
+	}
+	return result
+}
+
+// mergeMediumPosts는 피드 포스트를 우선하여 아카이브 포스트와 병합합니다.
+// 같은 포스트는 추적 파라미터를 뺀 주소로 판단하며, 피드 포스트의 빈 필드는 아카이브 값으로 채웁니다.
+// 기본값(complete)을 채우기 전의 포스트를 받아야 합니다.
+func mergeMediumPosts(feedPosts, archivePosts []models.BlogPost) []models.BlogPost {
+	posts := make([]models.BlogPost, 0, len(feedPosts)+len(archivePosts))
+	index := make(map[string]int)
+	for _, post := range feedPosts {
+		index[canonicalMediumURL(post.URL)] = len(posts)
+		posts = append(posts, post)
+	}
+
+	for _, post := range archivePosts {
+		key := canonicalMediumURL(post.URL)
+		i, ok := index[key]
+		if !ok {
+			index[key] = len(posts)
+			posts = append(posts, post)
+			continue
+		}
+		if strings.TrimSpace(posts[i].Author) == "" {
+			posts[i].Author = post.Author
+		}
+		if strings.TrimSpace(posts[i].Summary) == "" {
+			posts[i].Summary = post.Summary
+		}
+		if posts[i].Image == "" {
+			posts[i].Image = post.Image
+		}
+		if len(posts[i].Tags) == 0 {
+			posts[i].Tags = post.Tags
+		}
+	}
+	return posts
+}
+
+// canonicalMediumURL은 쿼리와 프래그먼트를 제거한 주소를 반환합니다.
+func canonicalMediumURL(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return raw
+	}
+	u.RawQuery = ""
+	u.Fragment = ""
+	return strings.TrimRight(u.String(), "/")
+}
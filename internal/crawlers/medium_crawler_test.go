@@ -0,0 +1,68 @@
+package crawlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"hello-go/internal/models"
+)
+
+func TestMediumArchiveFillsFeedGapsBeforeDefaults(t *testing.T) {
+	now := time.Now().UTC()
+	published := now.Add(-time.Hour)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/feed/tech", func(w http.ResponseWriter, r *http.Request) {
+		// 작성자와 본문이 없는 피드 항목
+		fmt.Fprintf(w, `<?xml version="1.0"?>
+<rss xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:content="http://purl.org/rss/1.0/modules/content/"><channel>
+  <item><title>피드 포스트</title><link>%s/tech/feed-post-1?source=rss</link><pubDate>%s</pubDate><category>go</category></item>
+</channel></rss>`, "https://medium.example.com", published.Format(time.RFC1123))
+	})
+	mux.HandleFunc("/tech/archive/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `<html><body><script>window.__APOLLO_STATE__ = {
+			"Post:1": {"title": "피드 포스트", "mediumUrl": "https://medium.example.com/tech/feed-post-1",
+				"firstPublishedAt": %d, "creator": {"__ref": "User:1"},
+				"extendedPreviewContent": {"subtitle": "아카이브 부제목"}},
+			"User:1": {"name": "아카이브 작성자"}
+		};</script></body></html>`, published.UnixMilli())
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	pub := MediumPublication{Slug: "tech", Name: "테크", DefaultAuthor: "기본 작성자", DefaultSummary: "기본 요약", DefaultCategory: "개발"}
+	since := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	posts, err := NewMediumCrawlerWithURL(pub, since, server.URL).Crawl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 1 {
+		t.Fatalf("포스트 %d개, want 1: %+v", len(posts), posts)
+	}
+	post := posts[0]
+	if post.Author != "아카이브 작성자" || post.Summary != "아카이브 부제목" {
+		t.Errorf("Author = %q, Summary = %q; 아카이브 값이 기본값에 가려짐", post.Author, post.Summary)
+	}
+	if !strings.HasSuffix(post.URL, "?source=rss") || post.Category != "go" {
+		t.Errorf("피드 값이 우선하지 않음: %+v", post)
+	}
+}
+
+func TestMediumDefaultsWhenNoSourceHasValue(t *testing.T) {
+	c := NewMediumCrawler(MediumPublication{Name: "테크", DefaultAuthor: "기본 작성자", DefaultSummary: "기본 요약"}, time.Time{})
+	merged := mergeMediumPosts(
+		[]models.BlogPost{{URL: "https://medium.example.com/a"}},
+		[]models.BlogPost{{URL: "https://medium.example.com/a?source=archive"}},
+	)
+	if len(merged) != 1 {
+		t.Fatalf("병합 결과 %d개, want 1", len(merged))
+	}
+	post := c.complete(merged[0])
+	if post.Author != "기본 작성자" || post.Summary != "기본 요약" {
+		t.Errorf("기본값이 채워지지 않음: %+v", post)
+	}
+}
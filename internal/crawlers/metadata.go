@@ -0,0 +1,381 @@
+package crawlers
+
+import (
+	"encoding/json"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// 메타데이터 출처입니다. PageMeta.Sources와 BlogPost.Provenance에 기록됩니다.
+const (
+	MetaJSONLD  = "json-ld"
+	MetaOG      = "og"
+	MetaTwitter = "twitter"
+	MetaTag     = "meta"
+	MetaLink    = "link"
+	MetaContent = "content"
+)
+
+// PageMeta는 포스트 페이지의 메타데이터(JSON-LD, OpenGraph, Twitter Card, meta/link 태그)에서 찾은 정보입니다.
+type PageMeta struct {
+	Author      string
+	PublishedAt time.Time
+	UpdatedAt   time.Time
+	Description string
+	Tags        []string
+	// Image는 대표 이미지의 절대 주소입니다. 메타데이터에 없으면 본문 첫 이미지를 사용합니다.
+	Image string
+	// Sources는 필드 이름(author, published_at, updated_at, summary, tags, image)별로 값을 찾은 출처입니다.
+	Sources map[string]string
+}
+
+// articleTypes는 메타데이터를 읽는 JSON-LD @type입니다.
+var articleTypes = map[string]bool{
+	"Article":     true,
+	"BlogPosting": true,
+	"NewsArticle": true,
+	"TechArticle": true,
+}
+
+// unusableImageHints는 대표 이미지로 쓸 수 없는 이미지(추적 픽셀, 아바타, 아이콘)의 경로 조각, 파일 이름, class/alt 단어입니다.
+// 부분 문자열이 아니라 조각 단위로 비교하므로 go-profiler.png, error-tracking-cover.jpg 같은 이름은 걸리지 않습니다.
+var unusableImageHints = []string{
+	"avatar", "avatars", "gravatar", "profile", "프로필", "favicon", "emoji", "smilies", "spacer",
+	"pixel", "tracking", "beacon", "1x1", "stat",
+}
+
+// unusableImageHosts는 추적 픽셀이나 아바타만 제공하는 호스트입니다. 하위 도메인도 포함합니다.
+var unusableImageHosts = []string{"gravatar.com", "feedburner.com"}
+
+// metaValue는 출처가 붙은 메타데이터 후보 값입니다.
+type metaValue struct {
+	source string
+	value  string
+}
+
+// ParsePage는 포스트 페이지 문서에서 메타데이터를 찾습니다. 상대 주소는 pageURL 기준으로 해석합니다.
+// 필드마다 JSON-LD Article, OpenGraph(article:*), Twitter Card, meta 태그 순으로 먼저 찾은 값을 사용하며,
+// 이미지는 그다음 link rel=image_src, 본문 첫 이미지 순으로 찾습니다.
+func ParsePage(doc *goquery.Document, pageURL string) PageMeta {
+	meta := PageMeta{Sources: make(map[string]string)}
+	articles := jsonLDArticles(doc)
+
+	var authors, published, updated, descriptions, images []metaValue
+	var tags []string
+	tagSource := ""
+	for _, article := range articles {
+		if author := jsonLDNames(article["author"]); author != "" {
+			authors = append(authors, metaValue{MetaJSONLD, author})
+		}
+		if date, ok := article["datePublished"].(string); ok {
+			published = append(published, metaValue{MetaJSONLD, date})
+		}
+		if date, ok := article["dateModified"].(string); ok {
+			updated = append(updated, metaValue{MetaJSONLD, date})
+		}
+		if description, ok := article["description"].(string); ok {
+			descriptions = append(descriptions, metaValue{MetaJSONLD, description})
+		}
+		for _, image := range jsonLDImages(article["image"]) {
+			images = append(images, metaValue{MetaJSONLD, image})
+		}
+		if keywords := jsonLDKeywords(article["keywords"]); len(keywords) > 0 && tagSource == "" {
+			tags, tagSource = keywords, MetaJSONLD
+		}
+	}
+	if tagSource == "" {
+		doc.Find("meta[property='article:tag']").Each(func(i int, s *goquery.Selection) {
+			if tag := strings.TrimSpace(s.AttrOr("content", "")); tag != "" {
+				tags = append(tags, tag)
+			}
+		})
+		if len(tags) > 0 {
+			tagSource = MetaOG
+		}
+	}
+
+	// article:author는 프로필 주소인 경우가 많아 이름일 때만 사용
+	if author := metaContent(doc, "article:author"); !strings.HasPrefix(author, "http") {
+		authors = append(authors, metaValue{MetaOG, author})
+	}
+	authors = append(authors, metaValue{MetaTag, metaContent(doc, "author")})
+	published = append(published, metaValue{MetaOG, metaContent(doc, "article:published_time")})
+	updated = append(updated,
+		metaValue{MetaOG, metaContent(doc, "article:modified_time")},
+		metaValue{MetaOG, metaContent(doc, "og:updated_time")})
+	descriptions = append(descriptions,
+		metaValue{MetaOG, metaContent(doc, "og:description")},
+		metaValue{MetaTwitter, metaContent(doc, "twitter:description")},
+		metaValue{MetaTag, metaContent(doc, "description")})
+	images = append([]metaValue{
+		{MetaOG, metaContent(doc, "og:image")},
+		{MetaOG, metaContent(doc, "og:image:secure_url")},
+		{MetaOG, metaContent(doc, "og:image:url")},
+		{MetaTwitter, metaContent(doc, "twitter:image")},
+		{MetaTwitter, metaContent(doc, "twitter:image:src")},
+	}, images...)
+	// JSON-LD 이미지는 og:image보다 뒤에 확인 (대부분 같은 값이며, og:image가 카드용으로 더 잘 관리됨)
+	images = append(images, metaValue{MetaLink, doc.Find("link[rel='image_src']").AttrOr("href", "")})
+
+	for _, v := range authors {
+		if author := strings.Join(strings.Fields(v.value), " "); author != "" {
+			meta.Author, meta.Sources["author"] = author, v.source
+			break
+		}
+	}
+	for _, v := range published {
+		if t, ok := parseNextDataDate(strings.TrimSpace(v.value)); ok {
+			meta.PublishedAt, meta.Sources["published_at"] = t, v.source
+			break
+		}
+	}
+	for _, v := range updated {
+		if t, ok := parseNextDataDate(strings.TrimSpace(v.value)); ok {
+			meta.UpdatedAt, meta.Sources["updated_at"] = t, v.source
+			break
+		}
+	}
+	for _, v := range descriptions {
+		if description := strings.Join(strings.Fields(v.value), " "); description != "" {
+			meta.Description, meta.Sources["summary"] = description, v.source
+			break
+		}
+	}
+	if len(tags) > 0 {
+		meta.Tags, meta.Sources["tags"] = tags, tagSource
+	}
+	for _, v := range images {
+		if image := scrapedImageURL(pageURL, v.value); image != "" {
+			meta.Image, meta.Sources["image"] = image, v.source
+			break
+		}
+	}
+	if meta.Image == "" {
+		root := doc.Find("article").First()
+		if root.Length() == 0 {
+			root = doc.Find("main").First()
+		}
+		if root.Length() == 0 {
+			root = doc.Selection
+		}
+		if image := firstImage(root, pageURL); image != "" {
+			meta.Image, meta.Sources["image"] = image, MetaContent
+		}
+	}
+	return meta
+}
+
+// contentImage는 피드 본문 같은 HTML 조각에서 대표 이미지로 쓸 수 있는 첫 이미지를 찾습니다.
+func contentImage(fragment, pageURL string) string {
+	if fragment == "" {
+		return ""
+	}
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(fragment))
+	if err != nil {
+		return ""
+	}
+	return firstImage(doc.Selection, pageURL)
+}
+
+// firstImage는 s 안의 img 중 추적 픽셀, 아바타, 아이콘이 아닌 첫 이미지의 절대 주소를 반환합니다.
+// 지연 로딩용 data-src 속성도 확인합니다.
+func firstImage(s *goquery.Selection, pageURL string) string {
+	var image string
+	s.Find("img").EachWithBreak(func(i int, img *goquery.Selection) bool {
+		if tinyImage(img) || hasUnusableHint(strings.Fields(img.AttrOr("class", "")+" "+img.AttrOr("alt", ""))) {
+			return true
+		}
+		for _, attr := range []string{"src", "data-src", "data-lazy-src"} {
+			if src := scrapedImageURL(pageURL, img.AttrOr(attr, "")); src != "" {
+				image = src
+				return false
+			}
+		}
+		return true
+	})
+	return image
+}
+
+// imageURL은 이미지 주소를 pageURL 기준의 절대 주소로 바꿉니다. data URI이거나 http(s)가 아니면 빈 문자열을 반환합니다.
+// API가 대표 이미지로 지정한 썸네일에 사용하며, 추적 픽셀·아바타 여부는 확인하지 않습니다.
+func imageURL(pageURL, src string) string {
+	resolved := resolveURL(pageURL, src)
+	if !strings.HasPrefix(resolved, "http://") && !strings.HasPrefix(resolved, "https://") {
+		return ""
+	}
+	return resolved
+}
+
+// scrapedImageURL은 페이지 메타데이터나 본문에서 찾은 이미지 주소를 imageURL처럼 바꾸되,
+// 호스트, 경로 조각, 파일 이름이 추적 픽셀·아바타·아이콘으로 보이면 빈 문자열을 반환합니다.
+func scrapedImageURL(pageURL, src string) string {
+	resolved := imageURL(pageURL, src)
+	if resolved == "" {
+		return ""
+	}
+	u, err := url.Parse(resolved)
+	if err != nil {
+		return ""
+	}
+	host := strings.ToLower(u.Hostname())
+	for _, unusable := range unusableImageHosts {
+		if host == unusable || strings.HasSuffix(host, "."+unusable) {
+			return ""
+		}
+	}
+	var words []string
+	for _, segment := range strings.Split(u.Path, "/") {
+		words = append(words, strings.TrimSuffix(segment, path.Ext(segment)))
+	}
+	if hasUnusableHint(words) {
+		return ""
+	}
+	return resolved
+}
+
+// hasUnusableHint는 words 중 대표 이미지로 쓸 수 없는 이미지의 표시가 있는지 확인합니다.
+// 단어가 힌트와 같거나 힌트 뒤에 -, _가 이어지면(avatar_32, favicon-32x32) 해당합니다.
+func hasUnusableHint(words []string) bool {
+	for _, word := range words {
+		word = strings.ToLower(word)
+		for _, hint := range unusableImageHints {
+			if word == hint || strings.HasPrefix(word, hint+"-") || strings.HasPrefix(word, hint+"_") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// tinyImage는 width나 height 속성이 아이콘 크기(48px) 이하인 이미지인지 확인합니다.
+func tinyImage(img *goquery.Selection) bool {
+	for _, attr := range []string{"width", "height"} {
+		if n, err := strconv.Atoi(strings.TrimSuffix(img.AttrOr(attr, ""), "px")); err == nil && n <= 48 {
+			return true
+		}
+	}
+	return false
+}
+
+// metaContent는 property 또는 name이 key인 meta 태그의 content를 반환합니다.
+func metaContent(doc *goquery.Document, key string) string {
+	content := doc.Find("meta[property='"+key+"']").AttrOr("content", "")
+	if content == "" {
+		content = doc.Find("meta[name='"+key+"']").AttrOr("content", "")
+	}
+	return strings.TrimSpace(content)
+}
+
+// jsonLDArticles는 문서의 JSON-LD 중 @type이 Article 계열인 객체를 모두 반환합니다. @graph와 배열도 찾습니다.
+func jsonLDArticles(doc *goquery.Document) []map[string]any {
+	var articles []map[string]any
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case []any:
+			for _, item := range v {
+				walk(item)
+			}
+		case map[string]any:
+			if isArticle(v["@type"]) {
+				articles = append(articles, v)
+			}
+			if graph, ok := v["@graph"]; ok {
+				walk(graph)
+			}
+		}
+	}
+
+	doc.Find("script[type='application/ld+json']").Each(func(i int, s *goquery.Selection) {
+		var data any
+		if err := json.Unmarshal([]byte(s.Text()), &data); err == nil {
+			walk(data)
+		}
+	})
+	return articles
+}
+
+// isArticle은 JSON-LD @type(문자열 또는 문자열 배열)이 Article 계열인지 확인합니다.
+func isArticle(t any) bool {
+	switch t := t.(type) {
+	case string:
+		return articleTypes[t]
+	case []any:
+		for _, item := range t {
+			if s, ok := item.(string); ok && articleTypes[s] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// jsonLDImages는 JSON-LD image 값(문자열, ImageObject, 또는 그 배열)의 주소를 반환합니다.
+func jsonLDImages(v any) []string {
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case map[string]any:
+		if url, ok := v["url"].(string); ok {
+			return []string{url}
+		}
+		if url, ok := v["contentUrl"].(string); ok {
+			return []string{url}
+		}
+	case []any:
+		var urls []string
+		for _, item := range v {
+			urls = append(urls, jsonLDImages(item)...)
+		}
+		return urls
+	}
+	return nil
+}
+
+// jsonLDNames는 JSON-LD author 값(문자열, Person, 또는 그 배열)의 이름을 쉼표로 이어 반환합니다.
+func jsonLDNames(v any) string {
+	switch v := v.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case map[string]any:
+		name, _ := v["name"].(string)
+		return strings.TrimSpace(name)
+	case []any:
+		var names []string
+		for _, item := range v {
+			if name := jsonLDNames(item); name != "" {
+				names = append(names, name)
+			}
+		}
+		return strings.Join(names, ", ")
+	}
+	return ""
+}
+
+// jsonLDKeywords는 JSON-LD keywords 값(쉼표로 구분한 문자열 또는 문자열 배열)을 태그 목록으로 반환합니다.
+func jsonLDKeywords(v any) []string {
+	var raw []string
+	switch v := v.(type) {
+	case string:
+		raw = strings.Split(v, ",")
+	case []any:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				raw = append(raw, s)
+			}
+		}
+	}
+
+	var keywords []string
+	for _, keyword := range raw {
+		if keyword = strings.TrimSpace(keyword); keyword != "" {
+			keywords = append(keywords, keyword)
+		}
+	}
+	return keywords
+}
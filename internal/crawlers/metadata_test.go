@@ -0,0 +1,145 @@
+package crawlers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+func TestScrapedImageURL(t *testing.T) {
+	const page = "https://blog.example.com/posts/1"
+	tests := []struct {
+		src  string
+		want bool
+	}{
+		{"/images/go-profiler.png", true},
+		{"https://cdn.example.com/error-tracking-cover.jpg", true},
+		{"https://cdn.example.com/profiles-in-go/cover.png", true},
+		{"/avatars/123.png", false},
+		{"https://cdn.example.com/u/avatar_64.jpg", false},
+		{"/favicon-32x32.png", false},
+		{"https://stats.example.com/1x1.gif", false},
+		{"https://medium.com/_/stat?event=post.clientViewed", false},
+		{"https://secure.gravatar.com/avatar/abc?s=96", false},
+		{"https://feeds.feedburner.com/~r/blog/~4/abc", false},
+		{"data:image/gif;base64,R0lGOD", false},
+	}
+	for _, tt := range tests {
+		got := scrapedImageURL(page, tt.src)
+		if (got != "") != tt.want {
+			t.Errorf("scrapedImageURL(%q) = %q, want usable %v", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestImageURLKeepsAPIThumbnails(t *testing.T) {
+	// API가 지정한 썸네일은 경로에 profile이 있어도 그대로 사용
+	const src = "https://static.example.com/profile/cover.png"
+	if got := imageURL("https://toss.tech/", src); got != src {
+		t.Errorf("imageURL() = %q, want %q", got, src)
+	}
+}
+
+func TestFirstImageSkipsAvatarsByAttributes(t *testing.T) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<article>
+		<img class="author avatar" src="/u/kim.png">
+		<img alt="프로필 사진" src="/u/lee.png">
+		<img src="/icons/logo.png" width="32">
+		<img alt="Go profiler 결과" data-src="/images/flame.png">
+	</article>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := firstImage(doc.Selection, "https://blog.example.com/posts/1"); got != "https://blog.example.com/images/flame.png" {
+		t.Errorf("firstImage() = %q", got)
+	}
+}
+
+func parsePage(t *testing.T, head string) PageMeta {
+	t.Helper()
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(`<html><head>` + head + `</head><body><main><img src="/images/body.png"></main></body></html>`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ParsePage(doc, "https://blog.example.com/posts/1")
+}
+
+func TestParsePageImagePrecedence(t *testing.T) {
+	const (
+		og       = `<meta property="og:image" content="/images/og.png">`
+		twitter  = `<meta name="twitter:image" content="/images/twitter.png">`
+		jsonLD   = `<script type="application/ld+json">{"@type": "Article", "image": {"url": "/images/ld.png"}}</script>`
+		imageSrc = `<link rel="image_src" href="/images/link.png">`
+		avatar   = `<meta property="og:image" content="https://secure.gravatar.com/avatar/abc">`
+	)
+	tests := []struct {
+		name       string
+		head       string
+		wantImage  string
+		wantSource string
+	}{
+		{"og first", jsonLD + imageSrc + twitter + og, "/images/og.png", MetaOG},
+		{"twitter before json-ld", jsonLD + imageSrc + twitter, "/images/twitter.png", MetaTwitter},
+		{"json-ld before image_src", imageSrc + jsonLD, "/images/ld.png", MetaJSONLD},
+		{"image_src", imageSrc, "/images/link.png", MetaLink},
+		{"unusable og skipped", avatar + imageSrc, "/images/link.png", MetaLink},
+		{"body image", "", "/images/body.png", MetaContent},
+	}
+	for _, tt := range tests {
+		meta := parsePage(t, tt.head)
+		if meta.Image != "https://blog.example.com"+tt.wantImage || meta.Sources["image"] != tt.wantSource {
+			t.Errorf("%s: Image = %q (%s), want %s (%s)", tt.name, meta.Image, meta.Sources["image"], tt.wantImage, tt.wantSource)
+		}
+	}
+}
+
+func TestParsePageFieldPrecedence(t *testing.T) {
+	meta := parsePage(t, `
+		<meta name="author" content="meta 작성자">
+		<meta property="article:author" content="https://facebook.com/kim">
+		<meta property="article:published_time" content="2025-01-01T00:00:00Z">
+		<meta property="article:tag" content="og 태그">
+		<meta name="twitter:description" content="twitter 설명">
+		<meta name="description" content="meta 설명">
+		<script type="application/ld+json">[
+			{"@type": "BreadcrumbList", "author": "무시"},
+			{"@type": "TechArticle", "author": {"@type": "Person", "name": "김개발"},
+			 "datePublished": "2025-02-01", "keywords": ["Go", "JSON-LD"], "description": "ld 설명"}
+		]</script>`)
+
+	if meta.Author != "김개발" || meta.Sources["author"] != MetaJSONLD {
+		t.Errorf("Author = %q (%s)", meta.Author, meta.Sources["author"])
+	}
+	if !meta.PublishedAt.Equal(time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)) || meta.Sources["published_at"] != MetaJSONLD {
+		t.Errorf("PublishedAt = %v (%s)", meta.PublishedAt, meta.Sources["published_at"])
+	}
+	if fmt.Sprint(meta.Tags) != "[Go JSON-LD]" || meta.Sources["tags"] != MetaJSONLD {
+		t.Errorf("Tags = %v (%s)", meta.Tags, meta.Sources["tags"])
+	}
+	if meta.Description != "ld 설명" || meta.Sources["summary"] != MetaJSONLD {
+		t.Errorf("Description = %q (%s)", meta.Description, meta.Sources["summary"])
+	}
+
+	// JSON-LD가 없으면 OpenGraph, Twitter Card, meta 태그 순이며, 주소인 article:author는 건너뜀
+	meta = parsePage(t, `
+		<meta name="author" content="meta 작성자">
+		<meta property="article:author" content="https://facebook.com/kim">
+		<meta property="article:tag" content="og 태그">
+		<meta name="twitter:description" content="twitter 설명">
+		<meta name="description" content="meta 설명">`)
+	if meta.Author != "meta 작성자" || meta.Sources["author"] != MetaTag {
+		t.Errorf("Author = %q (%s)", meta.Author, meta.Sources["author"])
+	}
+	if fmt.Sprint(meta.Tags) != "[og 태그]" || meta.Sources["tags"] != MetaOG {
+		t.Errorf("Tags = %v (%s)", meta.Tags, meta.Sources["tags"])
+	}
+	if meta.Description != "twitter 설명" || meta.Sources["summary"] != MetaTwitter {
+		t.Errorf("Description = %q (%s)", meta.Description, meta.Sources["summary"])
+	}
+	if !meta.PublishedAt.IsZero() {
+		t.Errorf("PublishedAt = %v, want zero", meta.PublishedAt)
+	}
+}
@@ -2,40 +2,63 @@ package crawlers
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
-	"regexp"
+	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/PuerkitoBio/goquery"
 
 	"hello-go/internal/models"
 )
 
-// min 함수는 두 정수 중 작은 값을 반환합니다.
-func min(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
-
 // NaverCrawler는 네이버 D2 기술 블로그를 크롤링합니다.
 type NaverCrawler struct {
-	client *http.Client
+	client  *http.Client
+	since   time.Time
+	baseURL string
+}
+
+const (
+	// naverBaseURL은 네이버 D2 사이트 주소입니다.
+	naverBaseURL = "https://d2.naver.com"
+	// naverMaxPages는 Atom 피드와 목록 API에서 가져올 최대 페이지 수입니다.
+	naverMaxPages = 30
+	// naverPageSize는 목록 API에 요청하는 페이지당 포스트 수입니다.
+	naverPageSize = 20
+)
+
+func init() {
+	Register(Entry{
+		ID:                 "naver",
+		Name:               "네이버 D2",
+		Homepage:           naverBaseURL + "/home",
+		SupportsSince:      true,
+		SupportsPagination: true,
+		NewCrawler:         func(opts Options) models.BlogCrawler { return NewNaverCrawler(opts.Since) },
+	})
 }
 
-func NewNaverCrawler() *NaverCrawler {
+// NewNaverCrawler는 새로운 NaverCrawler 인스턴스를 생성합니다. since 이전 포스트가 나오면 페이지네이션을 멈춥니다.
+func NewNaverCrawler(since time.Time) *NaverCrawler {
 	return &NaverCrawler{
-		client: &http.Client{
-			Timeout: 60 * time.Second,
-		},
+		client:  newHTTPClient("네이버 D2"),
+		since:   since,
+		baseURL: naverBaseURL,
 	}
 }
 
+// NewNaverCrawlerWithURL은 사이트 주소를 지정하여 NaverCrawler를 생성합니다. 테스트 서버에 사용합니다.
+func NewNaverCrawlerWithURL(since time.Time, baseURL string) *NaverCrawler {
+	c := NewNaverCrawler(since)
+	c.baseURL = strings.TrimSuffix(baseURL, "/")
+	return c
+}
+
 func (c *NaverCrawler) GetSource() models.BlogSource {
 	return models.BlogSource{
 		Name: "네이버 D2",
@@ -44,63 +67,28 @@ func (c *NaverCrawler) GetSource() models.BlogSource {
 }
 
 // Crawl은 네이버 D2 기술 블로그를 크롤링합니다.
+// Atom 피드를 RFC 5005 링크를 따라 가져오고, 피드에 없는 이전 포스트는 목록 API에서 가져옵니다.
 func (c *NaverCrawler) Crawl() ([]models.BlogPost, error) {
-	var allPosts []models.BlogPost
-
 	log.Printf("네이버 D2 기술 블로그 크롤링 시작")
 
-	// RSS 피드 크롤링 (여러 소스 시도)
-	rssURLs := []string{
-		"https://d2.naver.com/d2.atom",
-		"https://d2.naver.com/d2.atom?limit=50", // 더 많은 포스트 요청
-		"https://d2.naver.com/d2.atom?count=50", // 다른 파라미터 시도
-		"https://d2.naver.com/d2.atom?max=50",   // 다른 파라미터 시도
-		"https://d2.naver.com/d2.atom?size=50",  // 다른 파라미터 시도
+	feedPosts, feedErr := c.crawlFeed()
+	if feedErr != nil {
+		log.Printf("Atom 피드 크롤링 실패: %v", feedErr)
 	}
+	log.Printf("Atom 피드에서 %d개 포스트 발견", len(feedPosts))
 
-	for _, rssURL := range rssURLs {
-		log.Printf("RSS 피드 크롤링 중: %s", rssURL)
-
-		rssPosts, err := c.crawlRSS(rssURL)
-		if err != nil {
-			log.Printf("RSS 크롤링 실패 (%s): %v", rssURL, err)
-			continue
-		}
-
-		allPosts = append(allPosts, rssPosts...)
-		log.Printf("RSS에서 %d개 포스트 발견", len(rssPosts))
-
-		// 첫 번째 RSS 피드에서 포스트를 찾았으면 중단 (중복 방지)
-		if len(rssPosts) > 0 {
-			break
-		}
+	listPosts, listErr := c.crawlListing()
+	if listErr != nil {
+		log.Printf("목록 크롤링 실패: %v", listErr)
 	}
+	log.Printf("목록에서 %d개 포스트 발견", len(listPosts))
 
-	// 추가로 각 포스트의 상세 페이지에서 관련 포스트나 더 많은 정보를 가져오기 시도
-	log.Printf("포스트 상세 페이지에서 추가 정보 수집 시도...")
-	var additionalPosts []models.BlogPost
-
-	// 처음 몇 개 포스트의 상세 페이지를 확인
-	for i, post := range allPosts {
-		if i >= 5 { // 처음 5개 포스트만 확인 (성능 고려)
-			break
-		}
-
-		log.Printf("포스트 상세 페이지 확인 중: %s", post.Title)
-		detailPosts, err := c.crawlPostDetail(post.URL)
-		if err != nil {
-			log.Printf("상세 페이지 크롤링 실패: %v", err)
-			continue
-		}
-
-		additionalPosts = append(additionalPosts, detailPosts...)
+	if feedErr != nil && listErr != nil {
+		return nil, fmt.Errorf("Atom 피드와 목록 모두 실패: %w", errors.Join(feedErr, listErr))
 	}
 
-	allPosts = append(allPosts, additionalPosts...)
-	log.Printf("상세 페이지에서 %d개 추가 포스트 발견", len(additionalPosts))
-
-	// 중복 제거
-	uniquePosts := c.removeDuplicates(allPosts)
+	// 피드의 정보가 더 자세하므로 피드 포스트를 우선
+	uniquePosts := c.removeDuplicates(append(feedPosts, listPosts...))
 
 	// 최신순으로 정렬
 	c.sortByDate(uniquePosts)
@@ -109,210 +97,165 @@ func (c *NaverCrawler) Crawl() ([]models.BlogPost, error) {
 	return uniquePosts, nil
 }
 
-// crawlRSS는 RSS 피드를 크롤링합니다.
-func (c *NaverCrawler) crawlRSS(rssURL string) ([]models.BlogPost, error) {
-	resp, err := c.client.Get(rssURL)
+// crawlFeed는 Atom 피드의 항목을 포스트로 변환합니다.
+func (c *NaverCrawler) crawlFeed() ([]models.BlogPost, error) {
+	entries, err := crawlAtom(c.client, c.baseURL+"/d2.atom", c.since, naverMaxPages)
 	if err != nil {
-		return nil, fmt.Errorf("RSS 요청 실패: %v", err)
+		return nil, err
 	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("RSS 응답 읽기 실패: %v", err)
-	}
-
-	// RSS 피드 내용을 멀티라인으로 매칭
-	entryPattern := regexp.MustCompile(`(?s)<entry>(.*?)</entry>`)
-	entries := entryPattern.FindAllStringSubmatch(string(body), -1)
-
-	var posts []models.BlogPost
-	log.Printf("RSS 피드에서 %d개 엔트리 발견", len(entries))
 
-	for i, entry := range entries {
-		entryContent := entry[1]
+	posts := make([]models.BlogPost, 0, len(entries))
+	for _, entry := range entries {
+		postURL := resolveURL(c.baseURL, entry.Link("alternate"))
+		title := entry.Title.Text()
+		if postURL == "" || title == "" {
+			continue
+		}
 
-		// 제목 추출
-		titleMatch := regexp.MustCompile(`(?s)<title[^>]*>(.*?)</title>`)
-		titleMatches := titleMatch.FindStringSubmatch(entryContent)
-		title := ""
-		if len(titleMatches) > 1 {
-			title = strings.TrimSpace(titleMatches[1])
+		publishedAt, ok := entry.Time()
+		if !ok {
+			log.Printf("Atom 날짜 파싱 실패: %s", title)
+			publishedAt = time.Now()
 		}
 
-		// 링크 추출
-		linkMatch := regexp.MustCompile(`(?s)<link[^>]*href="([^"]*)"[^>]*/>`)
-		linkMatches := linkMatch.FindStringSubmatch(entryContent)
-		url := ""
-		if len(linkMatches) > 1 {
-			url = strings.TrimSpace(linkMatches[1])
+		summary := entry.Summary.Text()
+		if summary == "" {
+			summary = entry.Content.Text()
 		}
+		summary = truncateRunes(summary, 200)
 
-		// 카테고리 추출
-		categoryMatch := regexp.MustCompile(`(?s)<category[^>]*term="([^"]*)"[^>]*/>`)
-		categoryMatches := categoryMatch.FindStringSubmatch(entryContent)
-		category := ""
-		if len(categoryMatches) > 1 {
-			category = strings.TrimSpace(categoryMatches[1])
+		image := entry.Content.FirstImage(postURL)
+		if image == "" {
+			image = entry.Summary.FirstImage(postURL)
 		}
 
-		// ID 추출
-		idMatch := regexp.MustCompile(`(?s)<id[^>]*>(.*?)</id>`)
-		idMatches := idMatch.FindStringSubmatch(entryContent)
-		id := ""
-		if len(idMatches) > 1 {
-			id = strings.TrimSpace(idMatches[1])
+		author := entry.Author()
+		if author == "" {
+			author = "네이버 D2"
 		}
 
-		// 날짜 추출 (멀티라인 매칭)
-		// published 시간을 우선적으로 사용
-		publishedMatch := regexp.MustCompile(`(?s)<published[^>]*>(.*?)</published>`)
-		publishedMatches := publishedMatch.FindStringSubmatch(entryContent)
-		publishedAt := time.Now()
-
-		if len(publishedMatches) > 1 {
-			dateStr := strings.TrimSpace(publishedMatches[1])
-			log.Printf("RSS published 날짜 발견: %s", dateStr)
-			if t, err := c.parseAtomTime(dateStr); err == nil {
-				publishedAt = t
-				log.Printf("RSS published 날짜 파싱 성공: %v", publishedAt)
-			} else {
-				log.Printf("RSS published 날짜 파싱 실패: %v", err)
-			}
+		// 피드의 category term을 카테고리와 태그로 사용하고, 없을 때만 제목과 요약으로 추정
+		tags := entry.Terms()
+		var category string
+		if len(tags) > 0 {
+			category = tags[0]
 		} else {
-			// published가 없으면 updated 시간 사용
-			dateMatch := regexp.MustCompile(`(?s)<updated[^>]*>(.*?)</updated>`)
-			dateMatches := dateMatch.FindStringSubmatch(entryContent)
-			if len(dateMatches) > 1 {
-				dateStr := strings.TrimSpace(dateMatches[1])
-				log.Printf("RSS updated 날짜 발견: %s", dateStr)
-				if t, err := c.parseAtomTime(dateStr); err == nil {
-					publishedAt = t
-					log.Printf("RSS updated 날짜 파싱 성공: %v", publishedAt)
-				} else {
-					log.Printf("RSS updated 날짜 파싱 실패: %v", err)
-				}
-			}
+			category = c.determineCategory(title, summary)
 		}
 
-		// 내용 추출
-		contentMatch := regexp.MustCompile(`(?s)<content[^>]*type="html"[^>]*>(.*?)</content>`)
-		contentMatches := contentMatch.FindStringSubmatch(entryContent)
-		content := ""
-		if len(contentMatches) > 1 {
-			content = strings.TrimSpace(contentMatches[1])
-		}
+		posts = append(posts, models.BlogPost{
+			Title:       title,
+			URL:         postURL,
+			Author:      author,
+			PublishedAt: publishedAt,
+			Summary:     summary,
+			Source:      "네이버 D2",
+			Category:    category,
+			Tags:        tags,
+			Image:       image,
+		})
+	}
+	return posts, nil
+}
 
-		// 요약 추출 (HTML 태그 제거)
-		summary := c.stripHTMLTags(content)
-		if len(summary) > 200 {
-			summary = summary[:200] + "..."
+// naverContentsResponse는 D2 사이트가 글 목록 페이지에서 사용하는 목록 API의 응답입니다.
+type naverContentsResponse struct {
+	Content []struct {
+		PostTitle       string `json:"postTitle"`
+		URL             string `json:"url"`
+		PostImage       string `json:"postImage"`
+		PostHTML        string `json:"postHtml"`
+		PostPublishedAt int64  `json:"postPublishedAt"`
+		Author          string `json:"author"`
+	} `json:"content"`
+	TotalPages int  `json:"totalPages"`
+	Last       bool `json:"last"`
+}
+
+// crawlListing은 목록 API를 페이지 순서대로 가져옵니다. since 이전 포스트가 나오거나 마지막 페이지면 멈춥니다.
+func (c *NaverCrawler) crawlListing() ([]models.BlogPost, error) {
+	var posts []models.BlogPost
+	for page := 0; page < naverMaxPages; page++ {
+		contents, err := c.fetchContents(page)
+		if err != nil {
+			if page == 0 {
+				return nil, err
+			}
+			log.Printf("목록 페이지 %d 요청 실패: %v", page, err)
+			break
 		}
 
-		// 썸네일 이미지 추출
-		imageURL := c.extractThumbnail(content)
+		reached := false
+		for _, item := range contents.Content {
+			if item.URL == "" || item.PostTitle == "" {
+				continue
+			}
+
+			publishedAt := time.Now()
+			if item.PostPublishedAt > 0 {
+				publishedAt = time.UnixMilli(item.PostPublishedAt)
+			}
+			if !c.since.IsZero() && publishedAt.Before(c.since) {
+				reached = true
+			}
 
-		// 카테고리 결정
-		determinedCategory := c.determineCategory(title, summary)
-		if determinedCategory != "" {
-			category = determinedCategory
-		}
+			doc, _ := goquery.NewDocumentFromReader(strings.NewReader(item.PostHTML))
+			summary := ""
+			if doc != nil {
+				summary = truncateRunes(strings.Join(strings.Fields(doc.Text()), " "), 200)
+			}
 
-		// 첫 번째 포스트에 대해서만 상세한 JSON 출력
-		if i == 0 {
-			rssData := map[string]interface{}{
-				"title":               title,
-				"url":                 url,
-				"category":            category,
-				"id":                  id,
-				"publishedAt":         publishedAt,
-				"content_length":      len(content),
-				"summary_length":      len(summary),
-				"imageURL":            imageURL,
-				"determinedCategory":  determinedCategory,
-				"raw_content_preview": content[:min(len(content), 200)] + "...",
-				"raw_entry_content":   entryContent,
+			author := item.Author
+			if author == "" {
+				author = "네이버 D2"
 			}
 
-			jsonData, _ := json.MarshalIndent(rssData, "", "  ")
-			log.Printf("첫 번째 RSS 엔트리 상세 정보:\n%s", string(jsonData))
+			title := strings.TrimSpace(item.PostTitle)
+			posts = append(posts, models.BlogPost{
+				Title:       title,
+				URL:         resolveURL(c.baseURL, item.URL),
+				Author:      author,
+				PublishedAt: publishedAt,
+				Summary:     summary,
+				Source:      "네이버 D2",
+				Category:    c.determineCategory(title, summary),
+				Image:       imageURL(c.baseURL, item.PostImage),
+			})
 		}
+		log.Printf("목록 페이지 %d: %d개 포스트", page, len(contents.Content))
 
-		post := models.BlogPost{
-			Title:       title,
-			URL:         url,
-			Author:      "네이버 D2",
-			PublishedAt: publishedAt,
-			Summary:     summary,
-			Source:      "네이버 D2",
-			Category:    category,
-			Image:       imageURL,
+		if reached || contents.Last || len(contents.Content) == 0 || page+1 >= contents.TotalPages {
+			break
 		}
-
-		posts = append(posts, post)
 	}
-
 	return posts, nil
 }
 
-// parseAtomTime은 Atom 날짜 형식을 파싱합니다.
-func (c *NaverCrawler) parseAtomTime(dateStr string) (time.Time, error) {
-	// Atom 표준 날짜 형식 (ISO 8601)
-	formats := []string{
-		"2006-01-02T15:04:05Z",
-		"2006-01-02T15:04:05-07:00",
-		"2006-01-02T15:04:05.000Z",
-		time.RFC3339,
+func (c *NaverCrawler) fetchContents(page int) (naverContentsResponse, error) {
+	url := fmt.Sprintf("%s/api/v1/contents?categoryId=&page=%d&size=%d", c.baseURL, page, naverPageSize)
+	resp, err := c.client.Get(url)
+	if err != nil {
+		return naverContentsResponse{}, fmt.Errorf("목록 요청 실패: %w", err)
 	}
+	defer resp.Body.Close()
 
-	for _, format := range formats {
-		if t, err := time.Parse(format, dateStr); err == nil {
-			return t, nil
-		}
+	if resp.StatusCode != http.StatusOK {
+		return naverContentsResponse{}, fmt.Errorf("목록 응답 오류: %d", resp.StatusCode)
 	}
 
-	return time.Now(), fmt.Errorf("Atom 날짜 형식을 파싱할 수 없습니다: %s", dateStr)
-}
-
-// stripHTMLTags는 HTML 태그와 엔티티를 제거합니다.
-func (c *NaverCrawler) stripHTMLTags(html string) string {
-	// HTML 엔티티 디코딩
-	html = strings.ReplaceAll(html, "&lt;", "<")
-	html = strings.ReplaceAll(html, "&gt;", ">")
-	html = strings.ReplaceAll(html, "&amp;", "&")
-	html = strings.ReplaceAll(html, "&nbsp;", " ")
-	html = strings.ReplaceAll(html, "&quot;", "\"")
-	html = strings.ReplaceAll(html, "&#39;", "'")
-
-	// HTML 태그 제거
-	tagPattern := regexp.MustCompile(`<[^>]*>`)
-	html = tagPattern.ReplaceAllString(html, "")
-
-	// 연속된 공백 정리
-	spacePattern := regexp.MustCompile(`\s+`)
-	html = spacePattern.ReplaceAllString(html, " ")
-
-	return strings.TrimSpace(html)
+	var contents naverContentsResponse
+	if err := json.NewDecoder(resp.Body).Decode(&contents); err != nil {
+		return naverContentsResponse{}, fmt.Errorf("목록 응답 파싱 실패: %w", err)
+	}
+	return contents, nil
 }
 
-// extractThumbnail은 HTML 콘텐츠에서 썸네일 이미지 URL을 추출합니다.
-func (c *NaverCrawler) extractThumbnail(content string) string {
-	// 이미지 URL 추출 (HTML 엔티티 처리)
-	imgMatch := regexp.MustCompile(`&lt;img[^&]+src=([^&>\s]+)`)
-	imgMatches := imgMatch.FindStringSubmatch(content)
-	if len(imgMatches) > 1 {
-		imageURL := strings.TrimSpace(imgMatches[1])
-		// 따옴표 제거
-		imageURL = strings.Trim(imageURL, `"'`)
-
-		// 상대 경로인 경우 절대 경로로 변환
-		if strings.HasPrefix(imageURL, "/") {
-			imageURL = "https://d2.naver.com" + imageURL
-		}
-
-		return imageURL
+// truncateRunes는 문자열을 최대 n글자로 자르고 잘린 경우 "..."를 붙입니다.
+func truncateRunes(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
 	}
-	return ""
+	return string([]rune(s)[:n]) + "..."
 }
 
 // determineCategory는 제목, 요약, URL을 분석해서 정확한 카테고리를 결정합니다.
@@ -405,75 +348,7 @@ func (c *NaverCrawler) removeDuplicates(posts []models.BlogPost) []models.BlogPo
 
 // sortByDate는 포스트를 최신순으로 정렬합니다.
 func (c *NaverCrawler) sortByDate(posts []models.BlogPost) {
-	// 내림차순 정렬 (최신순) - Go의 sort.Slice 사용
-	for i := 0; i < len(posts)-1; i++ {
-		for j := i + 1; j < len(posts); j++ {
-			if posts[i].PublishedAt.Before(posts[j].PublishedAt) {
-				posts[i], posts[j] = posts[j], posts[i]
-			}
-		}
-	}
-
-	log.Printf("포스트 정렬 완료: 최신 포스트는 '%s' (%v)", posts[0].Title, posts[0].PublishedAt)
-}
-
-// crawlPostDetail은 포스트 상세 페이지에서 관련 포스트나 추가 정보를 가져옵니다.
-func (c *NaverCrawler) crawlPostDetail(url string) ([]models.BlogPost, error) {
-	resp, err := c.client.Get(url)
-	if err != nil {
-		return nil, fmt.Errorf("상세 페이지 요청 실패: %w", err)
-	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("상세 페이지 응답 오류: %d", resp.StatusCode)
-	}
-
-	doc, err := goquery.NewDocumentFromReader(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("상세 페이지 HTML 파싱 실패: %w", err)
-	}
-
-	var relatedPosts []models.BlogPost
-
-	// 관련 포스트 링크 찾기
-	doc.Find("a[href*='/helloworld/'], a[href*='/news/']").Each(func(i int, s *goquery.Selection) {
-		href, exists := s.Attr("href")
-		if !exists {
-			return
-		}
-
-		// 절대 URL로 변환
-		if strings.HasPrefix(href, "/") {
-			href = "https://d2.naver.com" + href
-		}
-
-		// 이미 수집된 포스트인지 확인
-		for _, existingPost := range relatedPosts {
-			if existingPost.URL == href {
-				return
-			}
-		}
-
-		title := strings.TrimSpace(s.Text())
-		if title == "" {
-			title = "네이버 D2 포스트"
-		}
-
-		// 간단한 포스트 정보 생성
-		post := models.BlogPost{
-			Title:       title,
-			URL:         href,
-			Author:      "네이버 D2팀",
-			PublishedAt: time.Now(), // 정확한 날짜는 알 수 없으므로 현재 시간으로 설정
-			Summary:     "네이버 D2 기술 블로그 포스트",
-			Source:      "네이버 D2",
-			Category:    "엔지니어링",
-			Image:       "",
-		}
-
-		relatedPosts = append(relatedPosts, post)
+	sort.SliceStable(posts, func(i, j int) bool {
+		return posts[i].PublishedAt.After(posts[j].PublishedAt)
 	})
-
-	return relatedPosts, nil
 }
@@ -0,0 +1,86 @@
+package crawlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const naverFixtureFeed = `<?xml version="1.0" encoding="UTF-8"?>
+<feed xmlns="http://www.w3.org/2005/Atom">
+  <title>NAVER D2</title>
+  <entry>
+    <title>React 서버 컴포넌트 도입기</title>
+    <link rel="alternate" href="/helloworld/1001"/>
+    <published>2025-05-10T09:00:00+09:00</published>
+    <author><name>김개발</name></author>
+    <category term="FE"/>
+    <category term="React"/>
+    <summary type="html">&lt;p&gt;서버 컴포넌트를 적용한 경험&lt;/p&gt;&lt;img src="/content/images/1001.png"&gt;</summary>
+  </entry>
+  <entry>
+    <title>검색 품질 개선</title>
+    <link rel="alternate" href="/helloworld/1002"/>
+    <published>2025-04-01T09:00:00+09:00</published>
+    <summary>검색 랭킹 모델 이야기</summary>
+  </entry>
+</feed>`
+
+func newNaverFixture(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/d2.atom", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/atom+xml")
+		fmt.Fprint(w, naverFixtureFeed)
+	})
+	mux.HandleFunc("/api/v1/contents", func(w http.ResponseWriter, r *http.Request) {
+		// 피드와 겹치는 포스트 하나, 피드에 없는 이전 포스트 하나
+		fmt.Fprintf(w, `{"content": [
+			{"postTitle": "React 서버 컴포넌트 도입기", "url": "/helloworld/1001", "postPublishedAt": %d},
+			{"postTitle": "데이터 파이프라인 운영", "url": "/helloworld/900", "postImage": "/content/images/900.png",
+			 "postHtml": "<p>배치 작업 운영 경험</p>", "postPublishedAt": %d}
+		], "totalPages": 1, "last": true}`,
+			time.Date(2025, 5, 10, 0, 0, 0, 0, time.UTC).UnixMilli(),
+			time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC).UnixMilli())
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestNaverCategoriesFromFeedTerms(t *testing.T) {
+	server := newNaverFixture(t)
+	posts, err := NewNaverCrawlerWithURL(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), server.URL).Crawl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 3 {
+		t.Fatalf("포스트 %d개, want 3: %+v", len(posts), posts)
+	}
+
+	react, search, data := posts[0], posts[1], posts[2]
+	if react.Category != "FE" || fmt.Sprint(react.Tags) != "[FE React]" {
+		t.Errorf("term이 있는 포스트: Category = %q, Tags = %v", react.Category, react.Tags)
+	}
+	if react.URL != server.URL+"/helloworld/1001" || react.Author != "김개발" || react.Image != server.URL+"/content/images/1001.png" {
+		t.Errorf("피드 포스트 = %+v", react)
+	}
+	// term이 없으면 제목과 요약으로 추정
+	if search.Category != "검색" || len(search.Tags) != 0 {
+		t.Errorf("term이 없는 포스트: Category = %q, Tags = %v", search.Category, search.Tags)
+	}
+	if data.Category != "데이터" || data.Summary != "배치 작업 운영 경험" || data.Image != server.URL+"/content/images/900.png" {
+		t.Errorf("목록 포스트 = %+v", data)
+	}
+}
+
+func TestNaverFailsWhenFeedAndListingFail(t *testing.T) {
+	server := newNaverFixture(t)
+	crawler := NewNaverCrawlerWithURL(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), server.URL+"/missing/")
+
+	if _, err := crawler.Crawl(); err == nil {
+		t.Error("피드와 목록 모두 실패했는데 오류 없음")
+	}
+}
@@ -0,0 +1,308 @@
+package crawlers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"hello-go/internal/config"
+	"hello-go/internal/jsonpath"
+	"hello-go/internal/models"
+)
+
+// ErrNoNextData는 페이지에서 Next.js 데이터를 찾지 못했을 때 반환됩니다.
+var ErrNoNextData = errors.New("Next.js 데이터를 찾을 수 없습니다")
+
+// nextDataLayouts는 Next.js 데이터의 날짜 문자열을 파싱할 때 시도하는 형식입니다.
+var nextDataLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	"2006.01.02 15:04:05",
+	"2006.01.02",
+}
+
+// NextDataExtractor는 Next.js 페이지에 포함된 JSON 데이터에서 포스트 목록을 추출합니다.
+type NextDataExtractor struct {
+	cfg    config.NextDataConfig
+	posts  *jsonpath.Path
+	fields map[string][]*jsonpath.Path
+}
+
+// NewNextDataExtractor는 설정의 경로를 컴파일하여 새로운 NextDataExtractor 인스턴스를 생성합니다.
+func NewNextDataExtractor(cfg config.NextDataConfig) (*NextDataExtractor, error) {
+	if cfg.Posts == "" {
+		return nil, fmt.Errorf("next_data.posts가 필요합니다")
+	}
+	posts, err := jsonpath.Compile(cfg.Posts)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &NextDataExtractor{cfg: cfg, posts: posts, fields: make(map[string][]*jsonpath.Path)}
+	for name, exprs := range map[string][]string{
+		"title":        cfg.Fields.Title,
+		"url":          cfg.Fields.URL,
+		"author":       cfg.Fields.Author,
+		"published_at": cfg.Fields.PublishedAt,
+		"summary":      cfg.Fields.Summary,
+		"category":     cfg.Fields.Category,
+		"image":        cfg.Fields.Image,
+		"tags":         cfg.Fields.Tags,
+	} {
+		for _, expr := range exprs {
+			p, err := jsonpath.Compile(expr)
+			if err != nil {
+				return nil, err
+			}
+			e.fields[name] = append(e.fields[name], p)
+		}
+	}
+	if len(e.fields["title"]) == 0 || len(e.fields["url"]) == 0 {
+		return nil, fmt.Errorf("next_data.fields에 title과 url이 필요합니다")
+	}
+	return e, nil
+}
+
+// MustNextDataExtractor는 NewNextDataExtractor와 같지만 실패하면 panic합니다. 패키지 변수 초기화에 사용합니다.
+func MustNextDataExtractor(cfg config.NextDataConfig) *NextDataExtractor {
+	e, err := NewNextDataExtractor(cfg)
+	if err != nil {
+		panic(err)
+	}
+	return e
+}
+
+// Extract는 페이지의 Next.js 데이터에서 포스트를 추출합니다. 상대 URL은 pageURL 기준으로 변환합니다.
+// 데이터를 찾지 못하면 ErrNoNextData를 반환합니다.
+func (e *NextDataExtractor) Extract(doc *goquery.Document, pageURL string) ([]models.BlogPost, error) {
+	data, err := e.locate(doc)
+	if err != nil {
+		return nil, err
+	}
+	return e.Map(data, pageURL), nil
+}
+
+// Map은 디코딩된 JSON 데이터에서 Posts 경로의 포스트를 추출합니다. JSON API 응답에도 같은 설정을 사용할 수 있습니다.
+func (e *NextDataExtractor) Map(data any, pageURL string) []models.BlogPost {
+	var posts []models.BlogPost
+	for _, item := range e.posts.Find(data) {
+		post, ok := e.mapPost(item, pageURL)
+		if ok {
+			posts = append(posts, post)
+		}
+	}
+	return posts
+}
+
+// locate는 __NEXT_DATA__ 스크립트나 __NEXT_DATA__ 할당문을 찾고, 없으면 Marker를 포함한 JSON 객체를 디코딩합니다.
+func (e *NextDataExtractor) locate(doc *goquery.Document) (any, error) {
+	if script := doc.Find("script#__NEXT_DATA__").First(); script.Length() > 0 {
+		var data any
+		if err := json.Unmarshal([]byte(script.Text()), &data); err != nil {
+			return nil, fmt.Errorf("__NEXT_DATA__ 파싱 실패: %w", err)
+		}
+		return data, nil
+	}
+
+	var data any
+	var parseErr error
+	scripts := doc.Find("script")
+	// window.__NEXT_DATA__ = {...}; 처럼 할당문으로 들어 있는 경우
+	scripts.EachWithBreak(func(i int, s *goquery.Selection) bool {
+		var err error
+		if data, err = decodeAssignment(s.Text(), "__NEXT_DATA__"); err != nil {
+			parseErr = err
+		}
+		return data == nil
+	})
+	if data != nil {
+		return data, nil
+	}
+
+	if e.cfg.Marker != "" {
+		scripts.EachWithBreak(func(i int, s *goquery.Selection) bool {
+			content := s.Text()
+			anchor := strings.Index(content, e.cfg.Marker)
+			if anchor < 0 {
+				return true
+			}
+			var err error
+			if data, err = decodeEnclosing(content, anchor); err != nil {
+				parseErr = err
+			}
+			return data == nil
+		})
+	}
+	if data == nil {
+		if parseErr != nil {
+			return nil, fmt.Errorf("Next.js 데이터 파싱 실패: %w", parseErr)
+		}
+		return nil, ErrNoNextData
+	}
+	return data, nil
+}
+
+// decodeAssignment는 스크립트에서 name = {...} 할당문의 객체를 디코딩합니다. 할당문이 없으면 nil을 반환합니다.
+func decodeAssignment(content, name string) (any, error) {
+	for offset := 0; ; {
+		i := strings.Index(content[offset:], name)
+		if i < 0 {
+			return nil, nil
+		}
+		offset += i + len(name)
+
+		rest := strings.TrimLeft(content[offset:], " \t\r\n")
+		if !strings.HasPrefix(rest, "=") || strings.HasPrefix(rest, "==") {
+			continue
+		}
+		rest = strings.TrimLeft(rest[1:], " \t\r\n")
+		if !strings.HasPrefix(rest, "{") {
+			continue
+		}
+		// JSON 뒤의 세미콜론이나 다른 문장은 무시
+		var data any
+		if err := json.NewDecoder(strings.NewReader(rest)).Decode(&data); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+}
+
+// decodeEnclosing은 content[anchor]를 포함하는 가장 바깥의 JSON 객체를 디코딩합니다. 그런 객체가 없으면 nil을 반환합니다.
+// anchor 앞의 '{'부터 차례로 시도하며, 디코딩한 객체가 anchor 앞에서 끝나면 그 뒤부터 다시 찾습니다.
+func decodeEnclosing(content string, anchor int) (any, error) {
+	var lastErr error
+	for start := 0; start < anchor; {
+		i := strings.IndexByte(content[start:anchor], '{')
+		if i < 0 {
+			break
+		}
+		start += i
+
+		var data any
+		decoder := json.NewDecoder(strings.NewReader(content[start:]))
+		if err := decoder.Decode(&data); err != nil {
+			lastErr = err
+			start++
+			continue
+		}
+		end := start + int(decoder.InputOffset())
+		if end > anchor {
+			return data, nil
+		}
+		start = end
+	}
+	return nil, lastErr
+}
+
+// mapPost는 포스트 객체를 설정된 경로로 BlogPost에 매핑합니다. 제목이나 URL이 없으면 false를 반환합니다.
+func (e *NextDataExtractor) mapPost(item any, pageURL string) (models.BlogPost, bool) {
+	title := e.first(item, "title")
+	rawURL := e.first(item, "url")
+	if title == "" || rawURL == "" {
+		return models.BlogPost{}, false
+	}
+	if e.cfg.URLTemplate != "" {
+		rawURL = strings.ReplaceAll(e.cfg.URLTemplate, "{value}", rawURL)
+	}
+
+	post := models.BlogPost{
+		Title:   strings.TrimSpace(title),
+		URL:     resolveURL(pageURL, rawURL),
+		Author:  e.first(item, "author"),
+		Summary: strings.TrimSpace(e.first(item, "summary")),
+		Image:   imageURL(pageURL, e.first(item, "image")),
+	}
+	if published := e.first(item, "published_at"); published != "" {
+		if t, ok := parseNextDataDate(published); ok {
+			post.PublishedAt = t
+		}
+	}
+	post.Category = e.category(item)
+	post.Tags = e.all(item, "tags")
+	return post, true
+}
+
+// first는 필드의 경로 중 처음으로 비어 있지 않은 값을 반환합니다.
+func (e *NextDataExtractor) first(item any, field string) string {
+	for _, p := range e.fields[field] {
+		for _, v := range p.Find(item) {
+			if s := jsonpath.String(v); s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
+
+// all은 필드의 모든 경로에서 찾은 비어 있지 않은 값을 중복 없이 반환합니다.
+func (e *NextDataExtractor) all(item any, field string) []string {
+	var values []string
+	seen := make(map[string]bool)
+	for _, p := range e.fields[field] {
+		for _, v := range p.Find(item) {
+			s := strings.TrimSpace(jsonpath.String(v))
+			if s != "" && !seen[s] {
+				seen[s] = true
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
+
+// category는 Categories가 설정되어 있으면 허용된 첫 카테고리를, 아니면 첫 카테고리 값을 반환합니다.
+func (e *NextDataExtractor) category(item any) string {
+	if len(e.cfg.Categories) == 0 {
+		return e.first(item, "category")
+	}
+	for _, p := range e.fields["category"] {
+		for _, v := range p.Find(item) {
+			name := jsonpath.String(v)
+			for _, allowed := range e.cfg.Categories {
+				if name == allowed {
+					return name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// parseNextDataDate는 날짜 문자열이나 밀리초 단위 Unix 시각을 파싱합니다.
+func parseNextDataDate(value string) (time.Time, bool) {
+	for _, layout := range nextDataLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	if ms, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.UnixMilli(ms), true
+	}
+	return time.Time{}, false
+}
+
+// resolveURL은 pageURL을 기준으로 상대 URL을 절대 URL로 변환합니다.
+func resolveURL(pageURL, href string) string {
+	href = strings.TrimSpace(href)
+	if href == "" {
+		return ""
+	}
+	ref, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	base, err := url.Parse(pageURL)
+	if err != nil {
+		return href
+	}
+	return base.ResolveReference(ref).String()
+}
@@ -0,0 +1,138 @@
+package crawlers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"hello-go/internal/config"
+)
+
+// nextDataFixture는 Next.js pages 라우터가 만드는 __NEXT_DATA__ 스크립트 형태의 목록 페이지입니다.
+const nextDataFixture = `<!DOCTYPE html><html><head>
+<script>window.dataLayer = {"event": "pageview", "page": {"title": "블로그"}};</script>
+<script src="/_next/static/chunks/main.js" defer=""></script>
+</head><body><div id="__next"><h1>블로그</h1></div>
+<script id="__NEXT_DATA__" type="application/json">{"props":{"pageProps":{"posts":[
+  {"slug":"hello-next","title":"Next.js 도입기","excerpt":" 페이지 라우터 이야기 ","date":"2025-04-01T09:00:00.000Z",
+   "author":{"name":"김개발"},"coverImage":{"url":"/images/hello.png"},"categories":[{"name":"회고"},{"name":"Frontend"}]},
+  {"slug":"edge","title":"엣지 런타임","date":1740787200000,"author":{"name":"이개발"},"categories":[{"name":"Infra"}]},
+  {"slug":"untitled"}
+]},"__N_SSG":true},"page":"/blog","query":{},"buildId":"abc123","isFallback":false,"gsp":true,"scriptLoader":[]}</script>
+</body></html>`
+
+func newTestExtractor(t *testing.T, marker string) *NextDataExtractor {
+	t.Helper()
+	e, err := NewNextDataExtractor(config.NextDataConfig{
+		Marker:      marker,
+		Posts:       "$..posts[*]",
+		URLTemplate: "/blog/{value}",
+		Fields: config.NextDataFields{
+			Title:       []string{"title"},
+			URL:         []string{"slug"},
+			Author:      []string{"author.name"},
+			PublishedAt: []string{"publishedAt", "date"},
+			Summary:     []string{"excerpt"},
+			Image:       []string{"coverImage.url"},
+			Category:    []string{"categories[*].name"},
+			Tags:        []string{"categories[*].name"},
+		},
+		Categories: []string{"Frontend", "Infra"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func parseDoc(t *testing.T, html string) *goquery.Document {
+	t.Helper()
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(html))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestNextDataExtractsPosts(t *testing.T) {
+	posts, err := newTestExtractor(t, "").Extract(parseDoc(t, nextDataFixture), "https://blog.example.com/blog")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 제목이 없는 포스트는 제외
+	if len(posts) != 2 {
+		t.Fatalf("포스트 %d개, want 2: %+v", len(posts), posts)
+	}
+
+	post := posts[0]
+	if post.Title != "Next.js 도입기" || post.URL != "https://blog.example.com/blog/hello-next" || post.Author != "김개발" {
+		t.Errorf("포스트 = %+v", post)
+	}
+	if post.Summary != "페이지 라우터 이야기" || post.Image != "https://blog.example.com/images/hello.png" {
+		t.Errorf("Summary = %q, Image = %q", post.Summary, post.Image)
+	}
+	if !post.PublishedAt.Equal(time.Date(2025, 4, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("PublishedAt = %v", post.PublishedAt)
+	}
+	// Categories에 있는 첫 값을 카테고리로, 모든 값을 태그로 사용
+	if post.Category != "Frontend" || fmt.Sprint(post.Tags) != "[회고 Frontend]" {
+		t.Errorf("Category = %q, Tags = %v", post.Category, post.Tags)
+	}
+
+	// 밀리초 Unix 시각
+	if edge := posts[1]; !edge.PublishedAt.Equal(time.UnixMilli(1740787200000)) || edge.Category != "Infra" {
+		t.Errorf("둘째 포스트 = %+v", edge)
+	}
+}
+
+func TestNextDataAssignment(t *testing.T) {
+	html := `<html><head>
+<script>var config = {"env": "prod"}; if (window.__NEXT_DATA__ == null) {}</script>
+<script>window.__NEXT_DATA__ = {"props":{"pageProps":{"posts":[{"slug":"a","title":"할당문 포스트"}]}}};
+window.__NEXT_LOADED_PAGES__ = [];</script>
+</head></html>`
+
+	posts, err := newTestExtractor(t, "").Extract(parseDoc(t, html), "https://blog.example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 1 || posts[0].Title != "할당문 포스트" {
+		t.Errorf("posts = %+v", posts)
+	}
+}
+
+func TestNextDataMarkerSkipsPrecedingObjects(t *testing.T) {
+	// 마커 앞에 다른 객체가 있어도 마커를 포함한 객체를 디코딩
+	html := `<html><body><script>
+var analytics = {"id": "UA-1"};
+function init() { track(analytics); }
+window.__REACT_QUERY_STATE__ = {"dehydratedState": {"queries": [{"state": {"data": {"posts": [{"slug": "rq", "title": "React Query 포스트"}]}}}]}};
+</script></body></html>`
+
+	posts, err := newTestExtractor(t, "dehydratedState").Extract(parseDoc(t, html), "https://blog.example.com/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 1 || posts[0].Title != "React Query 포스트" {
+		t.Errorf("posts = %+v", posts)
+	}
+}
+
+func TestNextDataNotFound(t *testing.T) {
+	html := `<html><body><script>var x = {"a": 1};</script></body></html>`
+	for _, marker := range []string{"", "dehydratedState"} {
+		_, err := newTestExtractor(t, marker).Extract(parseDoc(t, html), "https://blog.example.com/")
+		if !errors.Is(err, ErrNoNextData) {
+			t.Errorf("marker %q: err = %v, want ErrNoNextData", marker, err)
+		}
+	}
+
+	broken := `<html><body><script id="__NEXT_DATA__" type="application/json">{"props":</script></body></html>`
+	if _, err := newTestExtractor(t, "").Extract(parseDoc(t, broken), "https://blog.example.com/"); err == nil || errors.Is(err, ErrNoNextData) {
+		t.Errorf("깨진 __NEXT_DATA__: err = %v", err)
+	}
+}
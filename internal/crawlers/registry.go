@@ -0,0 +1,165 @@
+package crawlers
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"hello-go/internal/config"
+	"hello-go/internal/models"
+)
+
+// Options는 모든 크롤러가 공통으로 받는 생성 옵션입니다.
+type Options struct {
+	// Since는 이 시각 이전 포스트가 나오면 페이지네이션을 멈추는 기준입니다. SupportsSince가 아닌 크롤러는 무시합니다.
+	Since time.Time
+}
+
+// Entry는 레지스트리에 등록된 크롤러의 팩토리와 메타데이터입니다.
+type Entry struct {
+	// ID는 --source, 이벤트의 sources 등에서 사용하는 소스 ID입니다.
+	ID       string
+	Name     string
+	Homepage string
+	// SupportsSince는 Options.Since까지만 거슬러 올라가 크롤링하는지 여부입니다.
+	SupportsSince bool
+	// SupportsPagination은 첫 페이지(또는 피드) 이후의 목록을 가져오는지 여부입니다.
+	SupportsPagination bool
+	// NeedsDetailFetch는 포스트마다 상세 페이지를 추가로 요청하는지 여부입니다.
+	NeedsDetailFetch bool
+	// NewCrawler는 옵션으로 크롤러를 생성합니다.
+	NewCrawler func(opts Options) models.BlogCrawler
+}
+
+var (
+	registryMu sync.RWMutex
+	registry   []Entry
+)
+
+// Register는 크롤러를 레지스트리에 등록합니다. 각 크롤러 파일의 init에서 호출합니다.
+// 같은 ID가 이미 있으면 대체하며, 설정 파일의 소스로 기본 크롤러를 덮어쓸 때 사용합니다.
+func Register(entry Entry) {
+	if entry.ID == "" || entry.NewCrawler == nil {
+		panic("crawlers: Register에 ID와 NewCrawler가 필요합니다")
+	}
+
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	for i := range registry {
+		if registry[i].ID == entry.ID {
+			registry[i] = entry
+			return
+		}
+	}
+	registry = append(registry, entry)
+}
+
+// Entries는 등록된 크롤러 목록을 등록 순서대로 반환합니다.
+func Entries() []Entry {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return append([]Entry(nil), registry...)
+}
+
+// Find는 ID에 해당하는 크롤러 정보를 반환합니다.
+func Find(id string) (Entry, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	for _, entry := range registry {
+		if entry.ID == id {
+			return entry, true
+		}
+	}
+	return Entry{}, false
+}
+
+// RegisterSources는 설정 파일의 소스(선택자 또는 Medium publication)를 레지스트리에 등록합니다.
+// 기존 크롤러와 ID가 같으면 설정의 크롤러로 대체합니다. 모든 설정을 먼저 검증하므로, 하나라도 잘못되면 아무것도 등록하지 않습니다.
+func RegisterSources(cfgs []config.SourceConfig) error {
+	entries := make([]Entry, 0, len(cfgs))
+	seen := make(map[string]bool)
+	for _, cfg := range cfgs {
+		newCrawler, err := sourceFactory(cfg)
+		if err != nil {
+			return err
+		}
+		if seen[cfg.ID] {
+			return fmt.Errorf("%s: 소스 ID가 중복됩니다", cfg.ID)
+		}
+		seen[cfg.ID] = true
+
+		entries = append(entries, Entry{
+			ID:                 cfg.ID,
+			Name:               cfg.Name,
+			Homepage:           newCrawler(time.Time{}).GetSource().URL,
+			SupportsSince:      true,
+			SupportsPagination: cfg.Medium != "" || cfg.Pagination.Pattern != "" || cfg.Pagination.Next != "",
+			NeedsDetailFetch:   cfg.Medium == "" && cfg.Detail != nil,
+			NewCrawler: func(opts Options) models.BlogCrawler {
+				return newCrawler(opts.Since)
+			},
+		})
+	}
+
+	for _, entry := range entries {
+		Register(entry)
+	}
+	return nil
+}
+
+// sourceFactory는 소스 설정을 검증하고 since로 크롤러를 만드는 함수를 반환합니다.
+// medium이 있으면 MediumCrawler를, 없으면 SelectorCrawler를 만들며, 설정 오류는 여기서 모두 반환하므로 반환한 함수는 실패하지 않습니다.
+func sourceFactory(cfg config.SourceConfig) (func(since time.Time) models.BlogCrawler, error) {
+	if cfg.Medium == "" {
+		crawler, err := NewSelectorCrawler(cfg, time.Time{})
+		if err != nil {
+			return nil, err
+		}
+		return crawler.withSince, nil
+	}
+	if cfg.ID == "" || cfg.Name == "" {
+		return nil, fmt.Errorf("소스 설정에 id와 name이 필요합니다")
+	}
+	pub := MediumPublication{
+		Slug:            cfg.Medium,
+		Name:            cfg.Name,
+		DefaultAuthor:   cfg.Author,
+		DefaultSummary:  cfg.DefaultSummary,
+		DefaultCategory: cfg.DefaultCategory,
+	}
+	return func(since time.Time) models.BlogCrawler {
+		return NewMediumCrawler(pub, since)
+	}, nil
+}
+
+// SelectEntries는 ID 목록에 해당하는 크롤러 정보를 반환합니다. 목록이 비어 있으면 전체 목록을 반환합니다.
+func SelectEntries(ids []string) ([]Entry, error) {
+	if len(ids) == 0 {
+		return Entries(), nil
+	}
+
+	var entries []Entry
+	for _, id := range ids {
+		entry, ok := Find(strings.TrimSpace(id))
+		if !ok {
+			return nil, fmt.Errorf("알 수 없는 소스: %s", id)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// Select는 ID 목록으로 크롤러를 생성합니다. 목록이 비어 있으면 전체 크롤러를 반환합니다.
+func Select(ids []string, opts Options) ([]models.BlogCrawler, error) {
+	entries, err := SelectEntries(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	blogCrawlers := make([]models.BlogCrawler, 0, len(entries))
+	for _, entry := range entries {
+		blogCrawlers = append(blogCrawlers, entry.NewCrawler(opts))
+	}
+	return blogCrawlers, nil
+}
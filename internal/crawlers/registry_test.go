@@ -0,0 +1,72 @@
+package crawlers
+
+import (
+	"testing"
+	"time"
+
+	"hello-go/internal/config"
+)
+
+func registryConfig(id string) config.SourceConfig {
+	return config.SourceConfig{
+		ID:          id,
+		Name:        "레지스트리 " + id,
+		ListingURLs: []string{"https://registry.example.com/" + id},
+		Item:        "li.post",
+		Fields: config.FieldSelectors{
+			Title: config.Selector{Selector: "h2"},
+			URL:   config.Selector{Selector: "a", Attr: "href"},
+			Date:  config.Selector{Selector: ".date"},
+		},
+		DateFormat: "2006-01-02",
+	}
+}
+
+func TestRegisterSourcesValidatesAllBeforeRegistering(t *testing.T) {
+	broken := registryConfig("registry-broken")
+	broken.DateFormat = ""
+	duplicate := registryConfig("registry-duplicate")
+
+	tests := []struct {
+		name string
+		cfgs []config.SourceConfig
+	}{
+		{"invalid config", []config.SourceConfig{registryConfig("registry-valid-1"), broken}},
+		{"duplicate id", []config.SourceConfig{registryConfig("registry-valid-2"), duplicate, duplicate}},
+		{"invalid medium", []config.SourceConfig{registryConfig("registry-valid-3"), {ID: "registry-medium", Medium: "pub"}}},
+	}
+	for _, tt := range tests {
+		if err := RegisterSources(tt.cfgs); err == nil {
+			t.Errorf("%s: 오류 없음", tt.name)
+		}
+		// 앞의 설정이 올바라도 등록하지 않음
+		for _, cfg := range tt.cfgs {
+			if _, ok := Find(cfg.ID); ok {
+				t.Errorf("%s: %s가 등록됨", tt.name, cfg.ID)
+			}
+		}
+	}
+}
+
+func TestRegisterSourcesCreatesCrawlerPerSince(t *testing.T) {
+	if err := RegisterSources([]config.SourceConfig{registryConfig("registry-ok")}); err != nil {
+		t.Fatal(err)
+	}
+	entry, ok := Find("registry-ok")
+	if !ok {
+		t.Fatal("등록되지 않음")
+	}
+	if entry.Homepage != "https://registry.example.com/registry-ok" || !entry.SupportsSince {
+		t.Errorf("entry = %+v", entry)
+	}
+
+	since := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	first, ok := entry.NewCrawler(Options{Since: since}).(*SelectorCrawler)
+	if !ok {
+		t.Fatalf("SelectorCrawler가 아님")
+	}
+	second := entry.NewCrawler(Options{}).(*SelectorCrawler)
+	if !first.since.Equal(since) || !second.since.IsZero() {
+		t.Errorf("since = %v, %v", first.since, second.since)
+	}
+}
@@ -0,0 +1,475 @@
+package crawlers
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/PuerkitoBio/goquery"
+
+	"hello-go/internal/config"
+	"hello-go/internal/models"
+)
+
+// defaultSelectorMaxPages는 페이지네이션 설정에 MaxPages가 없을 때의 최대 페이지 수입니다.
+const defaultSelectorMaxPages = 10
+
+// SelectorCrawler는 설정된 CSS 선택자로 피드가 없는 블로그를 크롤링합니다.
+type SelectorCrawler struct {
+	client *http.Client
+	cfg    config.SourceConfig
+	since  time.Time
+	base   *url.URL
+
+	itemURLPattern *regexp.Regexp
+	patterns       map[string]*regexp.Regexp
+	nextData       *NextDataExtractor
+}
+
+// NewSelectorCrawler는 설정을 검증하고 새로운 SelectorCrawler 인스턴스를 생성합니다.
+// since 이전 포스트만 있는 페이지를 만나면 페이지네이션을 멈춥니다.
+func NewSelectorCrawler(cfg config.SourceConfig, since time.Time) (*SelectorCrawler, error) {
+	if cfg.ID == "" || cfg.Name == "" {
+		return nil, fmt.Errorf("소스 설정에 id와 name이 필요합니다")
+	}
+	if len(cfg.ListingURLs) == 0 {
+		return nil, fmt.Errorf("%s: listing_urls가 필요합니다", cfg.ID)
+	}
+	if cfg.NextData == nil {
+		if cfg.Item == "" {
+			return nil, fmt.Errorf("%s: item 또는 next_data가 필요합니다", cfg.ID)
+		}
+		if !isConfigured(cfg.Fields.Title) || !isConfigured(cfg.Fields.URL) {
+			return nil, fmt.Errorf("%s: fields.title과 fields.url이 필요합니다", cfg.ID)
+		}
+	}
+
+	if isConfigured(cfg.Fields.Date) || (cfg.Detail != nil && isConfigured(cfg.Detail.Date)) {
+		if err := validateDateFormat(cfg.DateFormat); err != nil {
+			return nil, fmt.Errorf("%s: %w", cfg.ID, err)
+		}
+	}
+
+	baseURL := cfg.URL
+	if baseURL == "" {
+		baseURL = cfg.ListingURLs[0]
+	}
+	base, err := url.Parse(baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("%s: url 파싱 실패: %w", cfg.ID, err)
+	}
+
+	c := &SelectorCrawler{
+		client:   newHTTPClient(cfg.Name),
+		cfg:      cfg,
+		since:    since,
+		base:     base,
+		patterns: make(map[string]*regexp.Regexp),
+	}
+
+	if cfg.ItemURLPattern != "" {
+		if c.itemURLPattern, err = regexp.Compile(cfg.ItemURLPattern); err != nil {
+			return nil, fmt.Errorf("%s: item_url_pattern 컴파일 실패: %w", cfg.ID, err)
+		}
+	}
+
+	if cfg.NextData != nil {
+		if c.nextData, err = NewNextDataExtractor(*cfg.NextData); err != nil {
+			return nil, fmt.Errorf("%s: %w", cfg.ID, err)
+		}
+	}
+
+	selectors := fieldSelectors(cfg.Fields)
+	if cfg.Detail != nil {
+		selectors = append(selectors, fieldSelectors(*cfg.Detail)...)
+	}
+	for _, sel := range selectors {
+		if sel.Pattern == "" || c.patterns[sel.Pattern] != nil {
+			continue
+		}
+		re, err := regexp.Compile(sel.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s: 정규식 컴파일 실패 (%s): %w", cfg.ID, sel.Pattern, err)
+		}
+		c.patterns[sel.Pattern] = re
+	}
+
+	return c, nil
+}
+
+// validateDateFormat은 date_format이 날짜를 나타내는 Go time 레이아웃인지 확인합니다.
+func validateDateFormat(layout string) error {
+	if layout == "" {
+		return fmt.Errorf("date 선택자에는 date_format이 필요합니다")
+	}
+	// 레이아웃 요소가 없으면 Format 결과가 레이아웃과 같고, 잘못된 조합이면 다시 파싱할 수 없음
+	reference := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+	formatted := reference.Format(layout)
+	if formatted == layout {
+		return fmt.Errorf("date_format에 날짜 요소가 없습니다: %q (예: 2006.01.02)", layout)
+	}
+	if _, err := time.Parse(layout, formatted); err != nil {
+		return fmt.Errorf("date_format이 올바르지 않습니다 (%q): %w", layout, err)
+	}
+	return nil
+}
+
+func fieldSelectors(f config.FieldSelectors) []config.Selector {
+	return []config.Selector{f.Title, f.URL, f.Date, f.Summary, f.Image, f.Category, f.Author}
+}
+
+func isConfigured(sel config.Selector) bool {
+	return sel.Selector != "" || sel.Attr != "" || sel.Pattern != ""
+}
+
+func (c *SelectorCrawler) GetSource() models.BlogSource {
+	return models.BlogSource{
+		Name: c.cfg.Name,
+		URL:  c.base.String(),
+	}
+}
+
+// Crawl은 목록 페이지를 순서대로 크롤링하고, 상세 페이지 설정이 있으면 포스트마다 상세 정보를 채웁니다.
+func (c *SelectorCrawler) Crawl() ([]models.BlogPost, error) {
+	log.Printf("%s 크롤링 시작 (선택자)", c.cfg.Name)
+
+	var allPosts []models.BlogPost
+	seen := make(map[string]bool)
+	for i, pageURL := range c.cfg.ListingURLs {
+		posts, err := c.crawlListing(pageURL, seen)
+		if err != nil {
+			// 첫 목록 페이지를 읽지 못하면 실패로 처리
+			if i == 0 {
+				return nil, err
+			}
+			log.Printf("%s 목록 페이지 크롤링 실패 (%s): %v", c.cfg.Name, pageURL, err)
+			continue
+		}
+		allPosts = append(allPosts, posts...)
+	}
+
+	sort.Slice(allPosts, func(i, j int) bool {
+		return allPosts[i].PublishedAt.After(allPosts[j].PublishedAt)
+	})
+
+	log.Printf("%s 크롤링 완료: 총 %d개 포스트 발견", c.cfg.Name, len(allPosts))
+	return allPosts, nil
+}
+
+// crawlListing은 목록 페이지 하나와 그 뒤의 페이지들을 크롤링합니다.
+func (c *SelectorCrawler) crawlListing(firstURL string, seen map[string]bool) ([]models.BlogPost, error) {
+	maxPages := c.cfg.Pagination.MaxPages
+	if maxPages <= 0 {
+		maxPages = defaultSelectorMaxPages
+	}
+	start := c.cfg.Pagination.Start
+	if start == 0 {
+		start = 2
+	}
+
+	var posts []models.BlogPost
+	pageURL := firstURL
+	for page := 0; page < maxPages && pageURL != ""; page++ {
+		doc, err := c.fetch(pageURL)
+		if err != nil {
+			if page == 0 {
+				return nil, err
+			}
+			log.Printf("%s 페이지 %d 요청 실패: %v", c.cfg.Name, page+1, err)
+			break
+		}
+
+		pagePosts := c.parseListing(doc, pageURL, seen)
+		posts = append(posts, pagePosts...)
+		log.Printf("%s 페이지 %d: %d개 포스트", c.cfg.Name, page+1, len(pagePosts))
+
+		if len(pagePosts) == 0 || c.allBefore(pagePosts) {
+			break
+		}
+
+		// 다음 페이지 주소 결정
+		switch {
+		case c.cfg.Pagination.Next != "":
+			href, _ := doc.Find(c.cfg.Pagination.Next).First().Attr("href")
+			pageURL = c.resolve(pageURL, href)
+		case c.cfg.Pagination.Pattern != "":
+			pageURL = strings.ReplaceAll(c.cfg.Pagination.Pattern, "{page}", strconv.Itoa(start+page))
+		default:
+			pageURL = ""
+		}
+	}
+
+	return posts, nil
+}
+
+// withSince는 since만 바꾼 복사본을 반환합니다. 검증을 마친 설정과 컴파일한 패턴, HTTP 클라이언트는 공유합니다.
+func (c *SelectorCrawler) withSince(since time.Time) models.BlogCrawler {
+	crawler := *c
+	crawler.since = since
+	return &crawler
+}
+
+// allBefore는 페이지의 모든 포스트가 since 이전인지 확인합니다.
+func (c *SelectorCrawler) allBefore(posts []models.BlogPost) bool {
+	if c.since.IsZero() {
+		return false
+	}
+	for _, post := range posts {
+		if !post.PublishedAt.Before(c.since) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseListing은 목록 페이지에서 포스트를 추출합니다.
+func (c *SelectorCrawler) parseListing(doc *goquery.Document, pageURL string, seen map[string]bool) []models.BlogPost {
+	if c.nextData != nil {
+		return c.parseNextData(doc, pageURL, seen)
+	}
+
+	var posts []models.BlogPost
+	doc.Find(c.cfg.Item).Each(func(i int, s *goquery.Selection) {
+		rawURL, ok := c.extract(s, c.cfg.Fields.URL)
+		if !ok {
+			return
+		}
+		if c.itemURLPattern != nil && !c.itemURLPattern.MatchString(rawURL) {
+			return
+		}
+		postURL := c.resolve(pageURL, rawURL)
+		if postURL == "" || seen[postURL] {
+			return
+		}
+
+		title, ok := c.extract(s, c.cfg.Fields.Title)
+		if !ok || c.excluded(title) {
+			return
+		}
+		seen[postURL] = true
+
+		post := models.BlogPost{
+			Title: title,
+			URL:   postURL,
+		}
+		c.fill(&post, s, pageURL, c.cfg.Fields)
+		if post, ok := c.complete(post); ok {
+			posts = append(posts, post)
+		}
+	})
+	return posts
+}
+
+// parseNextData는 목록 페이지의 Next.js 데이터에서 포스트를 추출합니다.
+func (c *SelectorCrawler) parseNextData(doc *goquery.Document, pageURL string, seen map[string]bool) []models.BlogPost {
+	extracted, err := c.nextData.Extract(doc, pageURL)
+	if err != nil {
+		log.Printf("%s Next.js 데이터 추출 실패 (%s): %v", c.cfg.Name, pageURL, err)
+		return nil
+	}
+
+	var posts []models.BlogPost
+	for _, post := range extracted {
+		if c.itemURLPattern != nil && !c.itemURLPattern.MatchString(post.URL) {
+			continue
+		}
+		if seen[post.URL] || c.excluded(post.Title) {
+			continue
+		}
+		seen[post.URL] = true
+
+		if post.Category != "" {
+			post.Category = c.mapCategory(post.Category)
+		}
+		if post, ok := c.complete(post); ok {
+			posts = append(posts, post)
+		}
+	}
+	return posts
+}
+
+// complete는 상세 페이지로 비어 있는 필드를 채우고 기본값을 설정합니다.
+// 발행일을 끝까지 찾지 못한 포스트는 since 필터와 정렬에 쓸 수 없으므로 false를 반환합니다.
+func (c *SelectorCrawler) complete(post models.BlogPost) (models.BlogPost, bool) {
+	post.Source = c.cfg.Name
+	if post.Author == "" {
+		post.Author = c.cfg.Author
+	}
+
+	if c.cfg.Detail != nil {
+		if err := c.crawlDetail(&post); err != nil {
+			log.Printf("%s 상세 정보 가져오기 실패 (%s): %v", c.cfg.Name, post.URL, err)
+		}
+	}
+	if post.PublishedAt.IsZero() {
+		log.Printf("%s 발행일을 찾지 못해 제외: %s", c.cfg.Name, post.URL)
+		return post, false
+	}
+	c.applyDefaults(&post)
+	return post, true
+}
+
+// crawlDetail은 상세 페이지에서 비어 있는 필드를 채웁니다.
+func (c *SelectorCrawler) crawlDetail(post *models.BlogPost) error {
+	doc, err := c.fetch(post.URL)
+	if err != nil {
+		return err
+	}
+	c.fill(post, doc.Selection, post.URL, *c.cfg.Detail)
+	// 선택자로 찾지 못한 이미지는 페이지 메타데이터에서 찾음
+	if post.Image == "" {
+		post.Image = ParsePage(doc, post.URL).Image
+	}
+	return nil
+}
+
+// fill은 선택자로 찾은 값으로 포스트의 비어 있는 필드를 채웁니다.
+func (c *SelectorCrawler) fill(post *models.BlogPost, s *goquery.Selection, pageURL string, fields config.FieldSelectors) {
+	if post.Title == "" {
+		post.Title, _ = c.extract(s, fields.Title)
+	}
+	if post.PublishedAt.IsZero() {
+		if text, ok := c.extract(s, fields.Date); ok {
+			if t, err := time.Parse(c.cfg.DateFormat, text); err == nil {
+				post.PublishedAt = t
+			} else {
+				log.Printf("%s 날짜 파싱 실패: %s, 에러: %v", c.cfg.Name, text, err)
+			}
+		}
+	}
+	if post.Summary == "" {
+		post.Summary, _ = c.extract(s, fields.Summary)
+	}
+	if post.Image == "" {
+		if image, ok := c.extract(s, fields.Image); ok {
+			post.Image = imageURL(c.base.String(), c.resolve(pageURL, image))
+		}
+	}
+	if post.Category == "" {
+		if text, ok := c.extract(s, fields.Category); ok {
+			post.Category = c.mapCategory(text)
+		}
+	}
+	if author, ok := c.extract(s, fields.Author); ok {
+		post.Author = author
+	}
+}
+
+// applyDefaults는 끝까지 찾지 못한 필드에 기본값을 설정합니다.
+func (c *SelectorCrawler) applyDefaults(post *models.BlogPost) {
+	if post.Summary == "" {
+		post.Summary = c.cfg.DefaultSummary
+	}
+	if post.Category == "" {
+		post.Category = c.cfg.DefaultCategory
+	}
+	if post.Category == "" {
+		post.Category = "기타"
+	}
+}
+
+// extract는 선택자와 일치하는 요소 중 조건을 만족하는 첫 값을 반환합니다.
+func (c *SelectorCrawler) extract(s *goquery.Selection, sel config.Selector) (string, bool) {
+	if !isConfigured(sel) {
+		return "", false
+	}
+
+	matches := s
+	if sel.Selector != "" {
+		matches = s.Find(sel.Selector)
+	}
+
+	var value string
+	found := false
+	matches.EachWithBreak(func(i int, m *goquery.Selection) bool {
+		var v string
+		if sel.Attr != "" {
+			attr, exists := m.Attr(sel.Attr)
+			if !exists {
+				return true
+			}
+			v = attr
+		} else {
+			v = m.Text()
+		}
+		v = strings.Join(strings.Fields(v), " ")
+
+		if sel.Pattern != "" {
+			groups := c.patterns[sel.Pattern].FindStringSubmatch(v)
+			if groups == nil {
+				return true
+			}
+			if len(groups) > 1 {
+				v = groups[1]
+			}
+		}
+
+		length := utf8.RuneCountInString(v)
+		if v == "" || length < sel.MinLength || (sel.MaxLength > 0 && length > sel.MaxLength) {
+			return true
+		}
+
+		value, found = v, true
+		return false
+	})
+	return value, found
+}
+
+// excluded는 제외할 제목인지 확인합니다.
+func (c *SelectorCrawler) excluded(title string) bool {
+	for _, exclude := range c.cfg.ExcludeTitles {
+		if strings.EqualFold(title, exclude) {
+			return true
+		}
+	}
+	return false
+}
+
+// mapCategory는 카테고리 규칙으로 텍스트를 분류합니다. 규칙이 없으면 텍스트를 그대로 사용합니다.
+func (c *SelectorCrawler) mapCategory(text string) string {
+	if len(c.cfg.Categories) == 0 {
+		return text
+	}
+	lower := strings.ToLower(text)
+	for _, rule := range c.cfg.Categories {
+		for _, match := range rule.Match {
+			if strings.Contains(lower, strings.ToLower(match)) {
+				return rule.Category
+			}
+		}
+	}
+	return ""
+}
+
+// resolve는 pageURL을 기준으로 상대 URL을 절대 URL로 변환합니다.
+func (c *SelectorCrawler) resolve(pageURL, href string) string {
+	if pageURL == "" {
+		pageURL = c.base.String()
+	}
+	return resolveURL(pageURL, href)
+}
+
+func (c *SelectorCrawler) fetch(pageURL string) (*goquery.Document, error) {
+	resp, err := c.client.Get(pageURL)
+	if err != nil {
+		return nil, fmt.Errorf("페이지 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("페이지 응답 오류: %d", resp.StatusCode)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("HTML 파싱 실패: %w", err)
+	}
+	return doc, nil
+}
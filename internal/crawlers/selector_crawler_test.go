@@ -0,0 +1,205 @@
+package crawlers
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"hello-go/internal/config"
+)
+
+// selectorFixture는 목록 3페이지와 포스트 상세 페이지를 제공하는 테스트 서버입니다.
+type selectorFixture struct {
+	mu        sync.Mutex
+	requested []string
+}
+
+var selectorListings = map[string]string{
+	"/posts": `
+		<li class="post"><a href="/post/1"><h2>첫 포스트</h2></a><span class="date">2025.05.01</span><span class="tag">Backend 개발</span></li>
+		<li class="post"><a href="/post/2"><h2>목록에 날짜 없음</h2></a><span class="tag">인턴 회고</span></li>
+		<li class="post"><a href="/post/3"><h2>날짜를 알 수 없음</h2></a><span class="date">곧 공개</span></li>
+		<li class="post"><a href="/about"><h2>소개</h2></a></li>`,
+	"/posts/page/2": `
+		<li class="post"><a href="/post/4"><h2>둘째 페이지</h2></a><span class="date">2025.03.01</span><span class="tag">일상</span></li>
+		<li class="post"><a href="/post/1"><h2>첫 포스트</h2></a><span class="date">2025.05.01</span></li>`,
+	"/posts/page/3": `
+		<li class="post"><a href="/post/5"><h2>오래된 포스트</h2></a><span class="date">2024.06.01</span></li>`,
+	"/posts/page/4": `
+		<li class="post"><a href="/post/6"><h2>더 오래된 포스트</h2></a><span class="date">2024.01.01</span></li>`,
+}
+
+var selectorDetails = map[string]string{
+	"/post/1": `<meta property="og:image" content="/img/1.png"><p class="summary">첫 포스트의 요약</p>`,
+	"/post/2": `<span class="published">2025.04.10</span><img src="/img/2.png">`,
+}
+
+func (f *selectorFixture) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	f.requested = append(f.requested, r.URL.Path)
+	f.mu.Unlock()
+
+	if listing, ok := selectorListings[r.URL.Path]; ok {
+		fmt.Fprintf(w, `<html><body><ul>%s</ul></body></html>`, listing)
+		return
+	}
+	fmt.Fprintf(w, `<html><head>%s</head><body><h1>상세</h1></body></html>`, selectorDetails[r.URL.Path])
+}
+
+func (f *selectorFixture) wasRequested(path string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for _, p := range f.requested {
+		if p == path {
+			return true
+		}
+	}
+	return false
+}
+
+func newSelectorConfig(serverURL string) config.SourceConfig {
+	return config.SourceConfig{
+		ID:             "fixture",
+		Name:           "픽스처 블로그",
+		URL:            serverURL,
+		ListingURLs:    []string{serverURL + "/posts"},
+		Pagination:     config.PaginationConfig{Pattern: serverURL + "/posts/page/{page}"},
+		Item:           "li.post",
+		ItemURLPattern: `^/post/\d+$`,
+		Fields: config.FieldSelectors{
+			Title:    config.Selector{Selector: "h2"},
+			URL:      config.Selector{Selector: "a", Attr: "href"},
+			Date:     config.Selector{Selector: ".date"},
+			Category: config.Selector{Selector: ".tag"},
+		},
+		Detail: &config.FieldSelectors{
+			Date:    config.Selector{Selector: ".published"},
+			Summary: config.Selector{Selector: "p.summary"},
+			Image:   config.Selector{Selector: "img", Attr: "src"},
+		},
+		DateFormat: "2006.01.02",
+		Categories: []config.CategoryRule{
+			{Match: []string{"회고", "인턴"}, Category: "경험"},
+			{Match: []string{"개발"}, Category: "개발"},
+		},
+		DefaultCategory: "기타",
+		DefaultSummary:  "픽스처 블로그 포스트",
+	}
+}
+
+func TestSelectorCrawlsListingPagesAndDetails(t *testing.T) {
+	fixture := &selectorFixture{}
+	server := httptest.NewServer(fixture)
+	defer server.Close()
+
+	crawler, err := NewSelectorCrawler(newSelectorConfig(server.URL), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	posts, err := crawler.Crawl()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var titles []string
+	for _, post := range posts {
+		titles = append(titles, post.Title)
+	}
+	// 발행일을 찾지 못한 포스트와 URL 패턴이 다른 항목, 다른 페이지의 중복은 제외하고 최신순 정렬
+	if got, want := strings.Join(titles, ", "), "첫 포스트, 목록에 날짜 없음, 둘째 페이지, 오래된 포스트"; got != want {
+		t.Fatalf("titles = %s, want %s", got, want)
+	}
+	// since 이전 포스트만 있는 3페이지에서 멈춤
+	if fixture.wasRequested("/posts/page/4") {
+		t.Error("since 이전 페이지 이후를 요청함")
+	}
+
+	first, detailDate, second := posts[0], posts[1], posts[2]
+	if first.URL != server.URL+"/post/1" || first.Source != "픽스처 블로그" {
+		t.Errorf("첫 포스트 = %+v", first)
+	}
+	if !first.PublishedAt.Equal(time.Date(2025, 5, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("목록 날짜 = %v", first.PublishedAt)
+	}
+	// 상세 페이지 선택자로 요약을, 페이지 메타데이터로 이미지를 채움
+	if first.Summary != "첫 포스트의 요약" || first.Image != server.URL+"/img/1.png" {
+		t.Errorf("상세 필드: Summary = %q, Image = %q", first.Summary, first.Image)
+	}
+	if !detailDate.PublishedAt.Equal(time.Date(2025, 4, 10, 0, 0, 0, 0, time.UTC)) || detailDate.Image != server.URL+"/img/2.png" {
+		t.Errorf("상세 페이지 날짜 포스트 = %+v", detailDate)
+	}
+	if detailDate.Summary != "픽스처 블로그 포스트" {
+		t.Errorf("기본 요약 = %q", detailDate.Summary)
+	}
+
+	// 카테고리 규칙은 처음 일치하는 규칙을 사용하고, 일치하지 않으면 기본 카테고리
+	if first.Category != "개발" || detailDate.Category != "경험" || second.Category != "기타" || posts[3].Category != "기타" {
+		t.Errorf("카테고리 = %q, %q, %q, %q", first.Category, detailDate.Category, second.Category, posts[3].Category)
+	}
+}
+
+func TestSelectorNextPageLink(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/blog", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<div class="item"><a href="/p/1">하나</a><time>2025-02-01</time></div><a class="next" href="/blog?after=1">다음</a>`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	cfg := config.SourceConfig{
+		ID:          "next",
+		Name:        "다음 링크",
+		ListingURLs: []string{server.URL + "/blog"},
+		Pagination:  config.PaginationConfig{Next: "a.next", MaxPages: 3},
+		Item:        "div.item",
+		Fields: config.FieldSelectors{
+			Title: config.Selector{Selector: "a"},
+			URL:   config.Selector{Selector: "a", Attr: "href"},
+			Date:  config.Selector{Selector: "time"},
+		},
+		DateFormat: "2006-01-02",
+	}
+	crawler, err := NewSelectorCrawler(cfg, time.Time{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	posts, err := crawler.Crawl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 다음 페이지가 같은 포스트만 반환하면 중복으로 제외되어 멈춤
+	if len(posts) != 1 || posts[0].URL != server.URL+"/p/1" {
+		t.Errorf("posts = %+v", posts)
+	}
+}
+
+func TestSelectorValidatesDateFormat(t *testing.T) {
+	tests := []struct {
+		format  string
+		wantErr bool
+	}{
+		{"2006.01.02", false},
+		{"Jan 2, 2006", false},
+		{"", true},
+		{"YYYY-MM-DD", true},
+	}
+	for _, tt := range tests {
+		cfg := newSelectorConfig("https://example.com")
+		cfg.DateFormat = tt.format
+		_, err := NewSelectorCrawler(cfg, time.Time{})
+		if (err != nil) != tt.wantErr {
+			t.Errorf("date_format %q: err = %v, wantErr %v", tt.format, err, tt.wantErr)
+		}
+	}
+
+	// date 선택자가 없으면 date_format이 필요 없음
+	cfg := newSelectorConfig("https://example.com")
+	cfg.Fields.Date, cfg.Detail, cfg.DateFormat = config.Selector{}, nil, ""
+	if _, err := NewSelectorCrawler(cfg, time.Time{}); err != nil {
+		t.Errorf("date 선택자 없이 err = %v", err)
+	}
+}
@@ -1,8 +1,10 @@
 package crawlers
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"regexp"
@@ -12,13 +14,17 @@ import (
 
 	"github.com/PuerkitoBio/goquery"
 
+	"hello-go/internal/config"
 	"hello-go/internal/models"
+	"hello-go/internal/scheduler"
 )
 
 // TossCrawler는 토스 기술 블로그를 크롤링합니다.
 type TossCrawler struct {
 	client     *http.Client
 	filterDate time.Time
+	apiURL     string
+	siteURL    string
 }
 
 // Toss API 응답 구조체
@@ -49,16 +55,75 @@ type TossAPIResponse struct {
 	Total   int        `json:"total"`
 }
 
+// tossAPIEnvelope는 토스 공개 API의 응답 래퍼입니다. 래퍼 없이 TossAPIResponse가 바로 오는 경우도 있습니다.
+type tossAPIEnvelope struct {
+	ResultType string           `json:"resultType"`
+	Success    *TossAPIResponse `json:"success"`
+	Error      *struct {
+		Reason string `json:"reason"`
+	} `json:"error"`
+}
+
+const (
+	// tossAPIURL은 toss.tech가 글 목록을 가져오는 JSON 엔드포인트입니다.
+	tossAPIURL = "https://api-public.toss.im/api-public/v3/ipd-thor/api/v1/workspaces/15/posts"
+	// tossSiteURL은 API를 사용할 수 없을 때 스크래핑하는 목록 페이지 주소입니다.
+	tossSiteURL = "https://toss.tech/"
+	// tossPageSize는 API에 요청하는 페이지당 포스트 수입니다.
+	tossPageSize = 20
+	// tossMaxPages는 HTML 스크래핑 시 전체 페이지 수를 알 수 없을 때의 최대 페이지 수입니다.
+	tossMaxPages = 50
+)
+
+// tossNextData는 토스 블로그 목록 페이지의 dehydrated state에서 포스트를 추출합니다.
+var tossNextData = MustNextDataExtractor(config.NextDataConfig{
+	Marker: "dehydratedState",
+	Posts:  "$.props.pageProps.prefetchResult.dehydratedState.queries[*].state.data.results[*]",
+	Fields: config.NextDataFields{
+		Title:       []string{"title"},
+		URL:         []string{"key"},
+		Author:      []string{"editor.name"},
+		PublishedAt: []string{"publishedTime", "createdTime"},
+		Summary:     []string{"shortDescription"},
+		Category:    []string{"categories[*].name"},
+		// 우선순위: thumbnail > coverImage > image
+		Image: []string{"thumbnail", "coverImage", "image"},
+	},
+	URLTemplate: "https://toss.tech/article/{value}",
+	Categories:  []string{"개발", "데이터/ML"},
+})
+
+func init() {
+	Register(Entry{
+		ID:                 "toss",
+		Name:               "토스",
+		Homepage:           tossSiteURL,
+		SupportsSince:      true,
+		SupportsPagination: true,
+		// 목록에 썸네일이 없는 포스트는 상세 페이지에서 이미지를 가져옴
+		NeedsDetailFetch: true,
+		NewCrawler:       func(opts Options) models.BlogCrawler { return NewTossCrawler(opts.Since) },
+	})
+}
+
 // NewTossCrawler는 새로운 TossCrawler 인스턴스를 생성합니다.
 func NewTossCrawler(filterDate time.Time) *TossCrawler {
 	return &TossCrawler{
-		client: &http.Client{
-			Timeout: 60 * time.Second,
-		},
+		client:     newHTTPClient("토스"),
 		filterDate: filterDate,
+		apiURL:     tossAPIURL,
+		siteURL:    tossSiteURL,
 	}
 }
 
+// NewTossCrawlerWithURLs는 API와 사이트 주소를 지정하여 TossCrawler를 생성합니다. 테스트 서버에 사용합니다.
+func NewTossCrawlerWithURLs(filterDate time.Time, apiURL, siteURL string) *TossCrawler {
+	t := NewTossCrawler(filterDate)
+	t.apiURL = apiURL
+	t.siteURL = siteURL
+	return t
+}
+
 // GetSource는 토스 블로그 소스 정보를 반환합니다.
 func (t *TossCrawler) GetSource() models.BlogSource {
 	return models.BlogSource{
@@ -68,139 +133,238 @@ func (t *TossCrawler) GetSource() models.BlogSource {
 }
 
 // Crawl은 토스 기술 블로그를 크롤링합니다.
+// JSON API를 우선 사용하고, 첫 API 요청이 실패하면 목록 페이지 HTML을 스크래핑합니다.
+// 중간 페이지가 실패하면 그 전 페이지까지의 포스트와 오류를 함께 반환합니다.
 func (t *TossCrawler) Crawl() ([]models.BlogPost, error) {
-	log.Printf("토스 블로그 크롤링 시작 (병렬 페이지네이션)")
+	log.Printf("토스 블로그 크롤링 시작")
+
+	posts, err := t.crawlAPI()
+	if err != nil && len(posts) == 0 {
+		log.Printf("토스 API 크롤링 실패, HTML 스크래핑으로 전환: %v", err)
+		posts, err = t.crawlHTML()
+		if err != nil && len(posts) == 0 {
+			return nil, err
+		}
+	}
 
-	// 병렬 크롤링을 위한 구조체
-	type pageResult struct {
-		page  int
-		posts []models.BlogPost
-		err   error
+	// 이미지 추출을 병렬로 처리
+	t.extractImagesParallel(&posts)
+
+	if err != nil {
+		return posts, fmt.Errorf("토스 블로그 일부 페이지 크롤링 실패 (%d개 포스트까지 수집): %w", len(posts), err)
 	}
+	log.Printf("토스 블로그 크롤링 완료: 총 %d개 포스트 발견", len(posts))
+	return posts, nil
+}
 
-	// 결과를 저장할 채널
-	resultChan := make(chan pageResult, 50)
-	var wg sync.WaitGroup
+// crawlAPI는 JSON API를 호출합니다. 첫 페이지의 Total로 전체 페이지 수를 계산합니다.
+func (t *TossCrawler) crawlAPI() ([]models.BlogPost, error) {
+	first, err := t.fetchAPIPage(context.Background(), 1)
+	if err != nil {
+		return nil, err
+	}
 
-	// 동시 요청 수 제한 (서버 부하 방지)
-	maxConcurrent := 5
-	semaphore := make(chan struct{}, maxConcurrent)
+	totalPages := 1
+	if first.Total > 0 && len(first.Results) > 0 {
+		totalPages = (first.Total + tossPageSize - 1) / tossPageSize
+	}
+	log.Printf("토스 API: 전체 %d개 포스트, %d페이지", first.Total, totalPages)
 
-	// FilterDate를 지난 글이 발견된 페이지 번호를 추적
-	var cutoffPage int32 = 0
-	var cutoffMutex sync.RWMutex
+	firstPosts := t.convertAPIPosts(first.Results)
+	return t.crawlPages(firstPosts, totalPages, func(ctx context.Context, page int) ([]models.BlogPost, error) {
+		resp, err := t.fetchAPIPage(ctx, page)
+		if err != nil {
+			return nil, err
+		}
+		return t.convertAPIPosts(resp.Results), nil
+	})
+}
 
-	// 첫 번째 페이지로 총 페이지 수 확인
-	firstPagePosts, err := t.crawlPage("https://toss.tech/?page=1")
+// crawlHTML은 목록 페이지 HTML에서 포스트를 스크래핑합니다. 빈 페이지가 나오면 멈춥니다.
+func (t *TossCrawler) crawlHTML() ([]models.BlogPost, error) {
+	firstPosts, err := t.crawlPage(context.Background(), t.pageURL(1))
 	if err != nil {
 		return nil, fmt.Errorf("첫 페이지 크롤링 실패: %v", err)
 	}
+	return t.crawlPages(firstPosts, tossMaxPages, func(ctx context.Context, page int) ([]models.BlogPost, error) {
+		return t.crawlPage(ctx, t.pageURL(page))
+	})
+}
 
-	// 첫 번째 페이지 결과 추가
-	resultChan <- pageResult{page: 1, posts: firstPagePosts, err: nil}
-
-	// 나머지 페이지들을 병렬로 크롤링
-	maxPages := 50 // 최대 50페이지까지 시도
-	for page := 2; page <= maxPages; page++ {
-		// cutoffPage가 설정되면 더 이상 고루틴 생성하지 않음
-		cutoffMutex.RLock()
-		currentCutoff := cutoffPage
-		cutoffMutex.RUnlock()
+func (t *TossCrawler) pageURL(page int) string {
+	return fmt.Sprintf("%s?page=%d", t.siteURL, page)
+}
 
-		if currentCutoff > 0 && page > int(currentCutoff) {
-			log.Printf("페이지 %d 이후 크롤링 중단 (FilterDate 기준 페이지 %d)", page, currentCutoff)
-			break // 루프 자체를 종료
+// crawlPages는 2페이지부터 totalPages까지 모든 요청을 한꺼번에 시작하고, 동시 요청 수는 Scheduler의 호스트별 제한에 맡깁니다.
+// 결과는 페이지 순서대로 확인하여 FilterDate 이전 포스트가 있거나 비어 있는 첫 페이지에서 멈추고,
+// 아직 대기 중이거나 진행 중인 뒤 페이지 요청은 취소합니다.
+// 실패한 페이지가 있으면 그 전 페이지까지의 포스트와 오류를 반환합니다.
+// 결과는 요청 완료 순서와 관계없이 항상 같은 페이지까지 포함합니다.
+func (t *TossCrawler) crawlPages(firstPosts []models.BlogPost, totalPages int, fetch func(ctx context.Context, page int) ([]models.BlogPost, error)) ([]models.BlogPost, error) {
+	allPosts := firstPosts
+	if t.reachedFilterDate(firstPosts) || len(firstPosts) == 0 || totalPages < 2 {
+		return allPosts, nil
+	}
+
+	count := totalPages - 1
+	results := make([][]models.BlogPost, count)
+	errs := make([]error, count)
+	done := make([]chan struct{}, count)
+	cancels := make([]context.CancelFunc, count)
+	defer func() {
+		for _, cancel := range cancels {
+			cancel()
 		}
+	}()
+	for i := range count {
+		ctx, cancel := context.WithCancel(context.Background())
+		done[i], cancels[i] = make(chan struct{}), cancel
+		go func(i int) {
+			defer close(done[i])
+			results[i], errs[i] = fetch(ctx, i+2)
+		}(i)
+	}
+
+	for i := range count {
+		<-done[i]
+		page := i + 2
+		if errs[i] != nil {
+			return allPosts, fmt.Errorf("페이지 %d 크롤링 실패: %w", page, errs[i])
+		}
+		log.Printf("토스 블로그 페이지 %d: %d개 포스트", page, len(results[i]))
+		if len(results[i]) == 0 {
+			log.Printf("페이지 %d에서 포스트를 찾을 수 없음", page)
+			return allPosts, nil
+		}
+		allPosts = append(allPosts, results[i]...)
+		if t.reachedFilterDate(results[i]) {
+			log.Printf("페이지 %d에서 FilterDate(%s) 이전 포스트 발견, 크롤링 중단", page, t.filterDate.Format("2006-01-02"))
+			return allPosts, nil
+		}
+	}
 
-		wg.Add(1)
-		go func(pageNum int) {
-			defer wg.Done()
-
-			// 세마포어로 동시 요청 수 제한
-			semaphore <- struct{}{}
-			defer func() { <-semaphore }()
+	return allPosts, nil
+}
 
-			url := fmt.Sprintf("https://toss.tech/?page=%d", pageNum)
-			log.Printf("토스 블로그 페이지 %d 크롤링: %s", pageNum, url)
+// reachedFilterDate는 FilterDate 이전에 발행된 포스트가 있는지 확인합니다.
+func (t *TossCrawler) reachedFilterDate(posts []models.BlogPost) bool {
+	for _, post := range posts {
+		if post.PublishedAt.Before(t.filterDate) {
+			return true
+		}
+	}
+	return false
+}
 
-			pagePosts, err := t.crawlPage(url)
-			if err != nil {
-				log.Printf("페이지 %d 크롤링 실패: %v", pageNum, err)
-				resultChan <- pageResult{page: pageNum, posts: nil, err: err}
-				return
-			}
+// fetchAPIPage는 API에서 페이지 하나를 가져옵니다. 최신순으로 정렬된 결과를 요청합니다.
+func (t *TossCrawler) fetchAPIPage(ctx context.Context, page int) (TossAPIResponse, error) {
+	url := fmt.Sprintf("%s?page=%d&size=%d&sort=publishedTime,desc", t.apiURL, page, tossPageSize)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return TossAPIResponse{}, fmt.Errorf("토스 API 요청 생성 실패: %w", err)
+	}
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return TossAPIResponse{}, fmt.Errorf("토스 API 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
 
-			if len(pagePosts) == 0 {
-				log.Printf("페이지 %d에서 포스트를 찾을 수 없음", pageNum)
-				resultChan <- pageResult{page: pageNum, posts: nil, err: nil}
-				return
-			}
+	if resp.StatusCode != http.StatusOK {
+		return TossAPIResponse{}, fmt.Errorf("토스 API 응답 오류: %d", resp.StatusCode)
+	}
 
-			// FilterDate를 지난 글이 있는지 확인하고 cutoffPage 업데이트
-			for _, post := range pagePosts {
-				if post.PublishedAt.Before(t.filterDate) {
-					cutoffMutex.Lock()
-					if cutoffPage == 0 || pageNum < int(cutoffPage) {
-						cutoffPage = int32(pageNum)
-						log.Printf("FilterDate 기준 페이지 설정: %d (포스트: %s, 날짜: %v)", pageNum, post.Title, post.PublishedAt)
-					}
-					cutoffMutex.Unlock()
-					break
-				}
-			}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return TossAPIResponse{}, fmt.Errorf("토스 API 응답 읽기 실패: %w", err)
+	}
+	return parseTossAPIResponse(body)
+}
 
-			log.Printf("페이지 %d 완료: %d개 포스트", pageNum, len(pagePosts))
-			resultChan <- pageResult{page: pageNum, posts: pagePosts, err: nil}
-		}(page)
+// parseTossAPIResponse는 래퍼가 있거나 없는 API 응답을 파싱합니다.
+func parseTossAPIResponse(body []byte) (TossAPIResponse, error) {
+	var envelope tossAPIEnvelope
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return TossAPIResponse{}, fmt.Errorf("토스 API 응답 파싱 실패: %w", err)
+	}
+	if envelope.Error != nil {
+		return TossAPIResponse{}, fmt.Errorf("토스 API 오류: %s", envelope.Error.Reason)
+	}
+	if envelope.Success != nil {
+		return *envelope.Success, nil
+	}
 
-		// 서버 부하 방지를 위한 짧은 대기
-		time.Sleep(100 * time.Millisecond)
+	var response TossAPIResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return TossAPIResponse{}, fmt.Errorf("토스 API 응답 파싱 실패: %w", err)
+	}
+	if response.Results == nil {
+		return TossAPIResponse{}, fmt.Errorf("토스 API 응답에 results가 없습니다")
 	}
+	return response, nil
+}
 
-	// 모든 고루틴이 완료될 때까지 대기
-	go func() {
-		wg.Wait()
-		close(resultChan)
-	}()
+// convertAPIPosts는 API 포스트를 BlogPost로 변환합니다.
+func (t *TossCrawler) convertAPIPosts(results []TossPost) []models.BlogPost {
+	posts := make([]models.BlogPost, 0, len(results))
+	for _, post := range results {
+		// publishedTime을 우선적으로 사용, 없으면 createdTime 사용
+		var publishedAt time.Time
+		if post.PublishedTime != "" {
+			publishedAt, _ = t.parseDate(post.PublishedTime)
+		} else if post.CreatedTime != "" {
+			publishedAt, _ = t.parseDate(post.CreatedTime)
+		} else {
+			publishedAt = time.Now()
+		}
 
-	// 결과 수집 및 정렬
-	var allPosts []models.BlogPost
-	pageResults := make(map[int][]models.BlogPost)
+		// 카테고리 결정
+		category := "개발"
+		for _, cat := range post.Categories {
+			if cat.Name == "개발" || cat.Name == "데이터/ML" {
+				category = cat.Name
+				break
+			}
+		}
 
-	for result := range resultChan {
-		if result.err != nil {
-			continue
+		// 이미지 URL 결정 (우선순위: thumbnail > coverImage > image)
+		image := post.Thumbnail
+		if image == "" {
+			image = post.CoverImage
 		}
-		if len(result.posts) > 0 {
-			pageResults[result.page] = result.posts
+		if image == "" {
+			image = post.Image
 		}
-	}
 
-	// 페이지 순서대로 결과 정렬
-	for page := 1; page <= maxPages; page++ {
-		if posts, exists := pageResults[page]; exists {
-			allPosts = append(allPosts, posts...)
-		} else if page > 1 {
-			// 빈 페이지가 나오면 크롤링 종료
-			break
-		}
+		posts = append(posts, models.BlogPost{
+			Title:       post.Title,
+			URL:         fmt.Sprintf("https://toss.tech/article/%s", post.Key),
+			Author:      post.Editor.Name,
+			PublishedAt: publishedAt,
+			Summary:     post.ShortDescription,
+			Source:      "토스",
+			Category:    category,
+			Image:       imageURL(tossSiteURL, image),
+		})
 	}
-
-	// 이미지 추출을 병렬로 처리
-	t.extractImagesParallel(&allPosts)
-
-	log.Printf("토스 블로그 크롤링 완료: 총 %d개 포스트 발견", len(allPosts))
-	return allPosts, nil
+	return posts
 }
 
 // crawlPage는 특정 페이지를 크롤링합니다.
-func (t *TossCrawler) crawlPage(url string) ([]models.BlogPost, error) {
-	resp, err := t.client.Get(url)
+func (t *TossCrawler) crawlPage(ctx context.Context, url string) ([]models.BlogPost, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("토스 블로그 페이지 요청 생성 실패: %v", err)
+	}
+	resp, err := t.client.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("토스 블로그 페이지 로드 실패: %v", err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("토스 블로그 페이지 응답 오류: %d", resp.StatusCode)
+	}
+
 	doc, err := goquery.NewDocumentFromReader(resp.Body)
 	if err != nil {
 		return nil, fmt.Errorf("토스 블로그 HTML 파싱 실패: %v", err)
@@ -220,135 +384,29 @@ func (t *TossCrawler) crawlPage(url string) ([]models.BlogPost, error) {
 	return posts, nil
 }
 
+// extractFromJavaScript는 Next.js 데이터의 React Query dehydrated state에서 포스트를 추출합니다.
 func (t *TossCrawler) extractFromJavaScript(doc *goquery.Document) ([]models.BlogPost, error) {
-	var posts []models.BlogPost
-
-	log.Printf("JavaScript 데이터 추출 시작...")
-
-	// Next.js 데이터 스크립트 태그 찾기
-	doc.Find("script").Each(func(i int, s *goquery.Selection) {
-		scriptContent := s.Text()
-		log.Printf("스크립트 %d 내용 길이: %d", i, len(scriptContent))
+	posts, err := tossNextData.Extract(doc, t.siteURL)
+	if err != nil {
+		return nil, err
+	}
 
-		// 큰 스크립트의 경우 일부만 로그로 출력
-		if len(scriptContent) > 1000 {
-			log.Printf("스크립트 %d 내용 (처음 500자): %s", i, scriptContent[:500])
+	for i := range posts {
+		posts[i].Source = "토스"
+		if posts[i].Category == "" {
+			posts[i].Category = "개발"
 		}
-
-		// Next.js 데이터가 포함된 스크립트 찾기
-		if len(scriptContent) > 1000 && strings.Contains(scriptContent, "dehydratedState") {
-			log.Printf("Next.js 데이터 스크립트 발견")
-
-			// JSON 데이터 추출
-			jsonStart := strings.Index(scriptContent, "{")
-			if jsonStart == -1 {
-				return
-			}
-
-			jsonData := scriptContent[jsonStart:]
-			log.Printf("JSON 데이터 길이: %d", len(jsonData))
-
-			var nextData map[string]interface{}
-			if err := json.Unmarshal([]byte(jsonData), &nextData); err != nil {
-				log.Printf("JSON 파싱 실패: %v", err)
-				return
-			}
-
-			log.Printf("JSON 파싱 성공")
-
-			// props > pageProps > prefetchResult > dehydratedState > queries에서 포스트 데이터 찾기
-			if props, ok := nextData["props"].(map[string]interface{}); ok {
-				log.Printf("props 발견")
-				log.Printf("props 키들: %v", getKeys(props))
-				if pageProps, ok := props["pageProps"].(map[string]interface{}); ok {
-					log.Printf("pageProps 발견")
-					log.Printf("pageProps 키들: %v", getKeys(pageProps))
-					if prefetchResult, ok := pageProps["prefetchResult"].(map[string]interface{}); ok {
-						log.Printf("prefetchResult 발견")
-						log.Printf("prefetchResult 키들: %v", getKeys(prefetchResult))
-						if dehydratedState, ok := prefetchResult["dehydratedState"].(map[string]interface{}); ok {
-							log.Printf("dehydratedState 발견")
-							if queries, ok := dehydratedState["queries"].([]interface{}); ok {
-								log.Printf("queries 발견, 개수: %d", len(queries))
-								for _, query := range queries {
-									if queryMap, ok := query.(map[string]interface{}); ok {
-										if state, ok := queryMap["state"].(map[string]interface{}); ok {
-											if data, ok := state["data"].(string); ok {
-												log.Printf("API 데이터 발견, 길이: %d", len(data))
-												// API 응답 파싱
-												var apiResponse TossAPIResponse
-												if err := json.Unmarshal([]byte(data), &apiResponse); err == nil {
-													log.Printf("API 응답 파싱 성공, 포스트 수: %d", len(apiResponse.Results))
-													for _, post := range apiResponse.Results {
-														// publishedTime을 우선적으로 사용, 없으면 createdTime 사용
-														var publishedAt time.Time
-														if post.PublishedTime != "" {
-															publishedAt, _ = t.parseDate(post.PublishedTime)
-														} else if post.CreatedTime != "" {
-															publishedAt, _ = t.parseDate(post.CreatedTime)
-														} else {
-															publishedAt = time.Now()
-														}
-
-														// 카테고리 결정
-														category := "개발"
-														for _, cat := range post.Categories {
-															if cat.Name == "개발" || cat.Name == "데이터/ML" {
-																category = cat.Name
-																break
-															}
-														}
-
-														// 이미지 URL 결정 (우선순위: thumbnail > coverImage > image)
-														imageURL := ""
-														if post.Thumbnail != "" {
-															imageURL = post.Thumbnail
-														} else if post.CoverImage != "" {
-															imageURL = post.CoverImage
-														} else if post.Image != "" {
-															imageURL = post.Image
-														}
-														// API에서 이미지가 없으면 나중에 병렬로 처리
-
-														postURL := fmt.Sprintf("https://toss.tech/article/%s", post.Key)
-
-														blogPost := models.BlogPost{
-															Title:       post.Title,
-															URL:         postURL,
-															Author:      post.Editor.Name,
-															PublishedAt: publishedAt,
-															Summary:     post.ShortDescription,
-															Source:      "토스",
-															Category:    category,
-															Image:       imageURL,
-														}
-														posts = append(posts, blogPost)
-													}
-												}
-											}
-										}
-									}
-								}
-							}
-						}
-					}
-				}
-			}
+		// publishedTime, createdTime 모두 없으면 현재 시간으로 설정
+		if posts[i].PublishedAt.IsZero() {
+			posts[i].PublishedAt = time.Now()
 		}
-	})
+		// API에서 이미지가 없으면 나중에 병렬로 처리
+	}
+	log.Printf("Next.js 데이터에서 %d개 포스트 추출", len(posts))
 
 	return posts, nil
 }
 
-// getKeys는 map의 키들을 문자열 슬라이스로 반환합니다.
-func getKeys(m map[string]interface{}) []string {
-	keys := make([]string, 0, len(m))
-	for k := range m {
-		keys = append(keys, k)
-	}
-	return keys
-}
-
 func (t *TossCrawler) extractFromHTML(doc *goquery.Document) ([]models.BlogPost, error) {
 	var posts []models.BlogPost
 
@@ -539,65 +597,31 @@ func (t *TossCrawler) extractThumbnailFromPage(postURL string) string {
 		return ""
 	}
 
-	// og:image 메타 태그에서 이미지 추출
-	if ogImage := doc.Find("meta[property='og:image']").AttrOr("content", ""); ogImage != "" {
-		return ogImage
-	}
-
-	// twitter:image 메타 태그에서 이미지 추출
-	if twitterImage := doc.Find("meta[name='twitter:image']").AttrOr("content", ""); twitterImage != "" {
-		return twitterImage
-	}
-
-	// 첫 번째 이미지 태그에서 이미지 추출
-	if firstImage := doc.Find("img").First().AttrOr("src", ""); firstImage != "" {
-		return firstImage
-	}
-
-	return ""
+	return ParsePage(doc, postURL).Image
 }
 
-// extractImagesParallel은 포스트들의 이미지를 병렬로 추출합니다.
+// extractImagesParallel은 이미지가 없는 포스트의 상세 페이지에서 이미지를 병렬로 추출합니다.
+// 요청이 모두 같은 호스트로 가므로 Scheduler의 호스트별 제한만큼만 워커를 띄워, 포스트 수만큼 고루틴이 대기하지 않게 합니다.
 func (t *TossCrawler) extractImagesParallel(posts *[]models.BlogPost) {
+	indexes := make(chan int)
 	var wg sync.WaitGroup
-	imageChan := make(chan struct {
-		index int
-		image string
-	}, len(*posts))
-
-	// 동시 이미지 추출 수 제한
-	maxConcurrent := 10
-	semaphore := make(chan struct{}, maxConcurrent)
-
-	for i, post := range *posts {
-		if post.Image == "" { // 이미지가 없는 포스트만 처리
-			wg.Add(1)
-			go func(index int, postURL string, title string) {
-				defer wg.Done()
-
-				// 세마포어로 동시 요청 수 제한
-				semaphore <- struct{}{}
-				defer func() { <-semaphore }()
-
-				imageURL := t.extractThumbnailFromPage(postURL)
-				if imageURL != "" {
-					imageChan <- struct {
-						index int
-						image string
-					}{index: index, image: imageURL}
+	for range scheduler.Default().Limits().PerHost {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				if image := t.extractThumbnailFromPage((*posts)[i].URL); image != "" {
+					(*posts)[i].Image = image
 				}
-			}(i, post.URL, post.Title)
-		}
+			}
+		}()
 	}
 
-	// 모든 고루틴이 완료될 때까지 대기
-	go func() {
-		wg.Wait()
-		close(imageChan)
-	}()
-
-	// 이미지 URL 업데이트
-	for result := range imageChan {
-		(*posts)[result.index].Image = result.image
+	for i, post := range *posts {
+		if post.Image == "" {
+			indexes <- i
+		}
 	}
+	close(indexes)
+	wg.Wait()
 }
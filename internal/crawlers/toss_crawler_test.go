@@ -0,0 +1,208 @@
+package crawlers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"hello-go/internal/models"
+	"hello-go/internal/scheduler"
+)
+
+// tossFixture는 페이지마다 정해진 수의 포스트를 돌려주는 토스 API 테스트 서버입니다.
+type tossFixture struct {
+	total int
+	// oldFrom은 이 페이지부터 포스트 발행일이 since 이전입니다. 0이면 모두 최근 포스트입니다.
+	oldFrom int
+	// failPage는 500으로 응답하는 페이지입니다.
+	failPage int
+	// slowFrom은 이 페이지부터 응답을 늦춥니다. 0이면 늦추지 않습니다.
+	slowFrom int
+
+	mu        sync.Mutex
+	requested map[int]bool
+}
+
+func (f *tossFixture) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	f.mu.Lock()
+	f.requested[page] = true
+	f.mu.Unlock()
+
+	if page == f.failPage {
+		http.Error(w, "boom", http.StatusInternalServerError)
+		return
+	}
+	if f.slowFrom > 0 && page >= f.slowFrom {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+
+	published := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	if f.oldFrom > 0 && page >= f.oldFrom {
+		published = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	var results []map[string]any
+	for i := (page - 1) * tossPageSize; i < min(page*tossPageSize, f.total); i++ {
+		results = append(results, map[string]any{
+			"title":         fmt.Sprintf("포스트 %d", i),
+			"key":           fmt.Sprintf("post-%d", i),
+			"publishedTime": published.Format(time.RFC3339),
+			"thumbnail":     fmt.Sprintf("https://static.example.com/%d.png", i),
+		})
+	}
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"resultType": "SUCCESS",
+		"success":    map[string]any{"page": page, "total": f.total, "results": results},
+	})
+}
+
+func newTossFixture(t *testing.T, f *tossFixture) *httptest.Server {
+	t.Helper()
+	f.requested = make(map[int]bool)
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+	return server
+}
+
+var tossSince = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestTossPagesFromTotal(t *testing.T) {
+	fixture := &tossFixture{total: 45}
+	server := newTossFixture(t, fixture)
+
+	posts, err := NewTossCrawlerWithURLs(tossSince, server.URL, server.URL+"/").Crawl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 45 {
+		t.Errorf("포스트 %d개, want 45", len(posts))
+	}
+	if fixture.requested[4] {
+		t.Error("Total 기준 마지막 페이지(3) 이후를 요청함")
+	}
+	if posts[0].URL != "https://toss.tech/article/post-0" || posts[0].Image != "https://static.example.com/0.png" {
+		t.Errorf("첫 포스트 = %+v", posts[0])
+	}
+}
+
+func TestTossStopsAtSince(t *testing.T) {
+	fixture := &tossFixture{total: 1000, oldFrom: 2, slowFrom: 3}
+	server := newTossFixture(t, fixture)
+
+	posts, err := NewTossCrawlerWithURLs(tossSince, server.URL, server.URL+"/").Crawl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// since 이전 포스트가 있는 2페이지까지만 포함
+	if len(posts) != 2*tossPageSize {
+		t.Errorf("포스트 %d개, want %d", len(posts), 2*tossPageSize)
+	}
+	// 뒤 페이지는 Scheduler의 호스트별 제한만큼만 시작된 뒤 취소됨
+	fixture.mu.Lock()
+	defer fixture.mu.Unlock()
+	if limit := 2 + scheduler.Default().Limits().PerHost; len(fixture.requested) > limit {
+		t.Errorf("since에 도달한 뒤에도 %d개 페이지를 요청함 (최대 %d)", len(fixture.requested), limit)
+	}
+}
+
+func TestTossReturnsErrorOnLaterPageFailure(t *testing.T) {
+	fixture := &tossFixture{total: 100, failPage: 3}
+	server := newTossFixture(t, fixture)
+
+	posts, err := NewTossCrawlerWithURLs(tossSince, server.URL, server.URL+"/").Crawl()
+	if err == nil {
+		t.Fatal("중간 페이지 실패가 오류로 반환되지 않음")
+	}
+	if len(posts) != 2*tossPageSize {
+		t.Errorf("실패 전까지의 포스트 %d개, want %d", len(posts), 2*tossPageSize)
+	}
+}
+
+func TestTossFallsBackToHTML(t *testing.T) {
+	mux := http.NewServeMux()
+	var server *httptest.Server
+	mux.HandleFunc("/api", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("page") != "1" {
+			fmt.Fprint(w, `<html><body></body></html>`)
+			return
+		}
+		fmt.Fprintf(w, `<html><body>
+			<article><h2>HTML 포스트</h2><a href="%s/article/html-post">읽기</a>
+			<time datetime="2025-05-02">2025-05-02</time>
+			<img src="https://static.example.com/html.png"></article>
+		</body></html>`, server.URL)
+	})
+	mux.HandleFunc("/article/", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<html><head><meta property="og:image" content="https://static.example.com/og.png"></head></html>`)
+	})
+	server = httptest.NewServer(mux)
+	defer server.Close()
+
+	posts, err := NewTossCrawlerWithURLs(tossSince, server.URL+"/api", server.URL+"/").Crawl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(posts) != 1 {
+		t.Fatalf("포스트 %d개, want 1: %+v", len(posts), posts)
+	}
+	post := posts[0]
+	if post.Title != "HTML 포스트" || post.URL != server.URL+"/article/html-post" {
+		t.Errorf("포스트 = %+v", post)
+	}
+	if want := time.Date(2025, 5, 2, 0, 0, 0, 0, time.UTC); !post.PublishedAt.Equal(want) {
+		t.Errorf("PublishedAt = %v, want %v", post.PublishedAt, want)
+	}
+	if post.Image != "https://static.example.com/og.png" {
+		t.Errorf("상세 페이지 이미지 = %q", post.Image)
+	}
+}
+
+func TestTossExtractsImagesWithBoundedWorkers(t *testing.T) {
+	var current, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := current.Add(1)
+		defer current.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(2 * time.Millisecond)
+		fmt.Fprintf(w, `<html><head><meta property="og:image" content="/images%s.png"></head></html>`, r.URL.Path)
+	}))
+	defer server.Close()
+
+	posts := make([]models.BlogPost, 30)
+	for i := range posts {
+		posts[i].URL = fmt.Sprintf("%s/article/%d", server.URL, i)
+	}
+	posts[0].Image = "https://static.example.com/keep.png"
+
+	NewTossCrawlerWithURLs(tossSince, server.URL, server.URL+"/").extractImagesParallel(&posts)
+
+	if posts[0].Image != "https://static.example.com/keep.png" {
+		t.Errorf("이미 있던 이미지가 바뀜: %q", posts[0].Image)
+	}
+	for i, post := range posts[1:] {
+		if want := fmt.Sprintf("%s/images/article/%d.png", server.URL, i+1); post.Image != want {
+			t.Errorf("posts[%d].Image = %q, want %q", i+1, post.Image, want)
+		}
+	}
+	if limit := int32(scheduler.Default().Limits().PerHost); peak.Load() > limit {
+		t.Errorf("동시 요청 %d개, 제한 %d", peak.Load(), limit)
+	}
+}
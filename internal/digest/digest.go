@@ -0,0 +1,90 @@
+package digest
+
+import (
+	"sort"
+	"time"
+
+	"hello-go/internal/models"
+)
+
+// defaultWindow는 설정에 window_days가 없을 때의 기간입니다.
+const defaultWindow = 7 * 24 * time.Hour
+
+// CategoryGroup은 카테고리 하나에 속한 포스트 목록입니다.
+type CategoryGroup struct {
+	Category string
+	Posts    []models.BlogPost
+}
+
+// SourceGroup은 소스 하나의 포스트를 카테고리별로 묶은 목록입니다.
+type SourceGroup struct {
+	Source     string
+	Count      int
+	Categories []CategoryGroup
+}
+
+// Digest는 이메일 한 통에 담을 다이제스트 내용입니다.
+type Digest struct {
+	From   time.Time
+	Until  time.Time
+	Count  int
+	Groups []SourceGroup
+}
+
+// Select는 [until-window, until] 기간에 발행된 포스트 중 sources에 포함된 포스트만 반환합니다.
+// sources가 비어 있으면 모든 소스를 포함합니다.
+func Select(posts []models.BlogPost, until time.Time, window time.Duration, sources []string) []models.BlogPost {
+	from := until.Add(-window)
+	allowed := make(map[string]bool, len(sources))
+	for _, source := range sources {
+		allowed[source] = true
+	}
+
+	var selected []models.BlogPost
+	for _, post := range posts {
+		if post.PublishedAt.Before(from) || post.PublishedAt.After(until) {
+			continue
+		}
+		if len(allowed) > 0 && !allowed[post.Source] {
+			continue
+		}
+		selected = append(selected, post)
+	}
+	return selected
+}
+
+// New는 포스트를 소스와 카테고리별로 묶어 Digest를 생성합니다. 각 묶음은 최신순으로 정렬됩니다.
+func New(posts []models.BlogPost, until time.Time, window time.Duration) Digest {
+	bySource := make(map[string]map[string][]models.BlogPost)
+	for _, post := range posts {
+		if bySource[post.Source] == nil {
+			bySource[post.Source] = make(map[string][]models.BlogPost)
+		}
+		bySource[post.Source][post.Category] = append(bySource[post.Source][post.Category], post)
+	}
+
+	d := Digest{
+		From:  until.Add(-window),
+		Until: until,
+		Count: len(posts),
+	}
+	for source, byCategory := range bySource {
+		group := SourceGroup{Source: source}
+		for category, categoryPosts := range byCategory {
+			sort.Slice(categoryPosts, func(i, j int) bool {
+				return categoryPosts[i].PublishedAt.After(categoryPosts[j].PublishedAt)
+			})
+			group.Categories = append(group.Categories, CategoryGroup{Category: category, Posts: categoryPosts})
+			group.Count += len(categoryPosts)
+		}
+		sort.Slice(group.Categories, func(i, j int) bool {
+			return group.Categories[i].Category < group.Categories[j].Category
+		})
+		d.Groups = append(d.Groups, group)
+	}
+	sort.Slice(d.Groups, func(i, j int) bool {
+		return d.Groups[i].Source < d.Groups[j].Source
+	})
+
+	return d
+}
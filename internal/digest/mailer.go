@@ -0,0 +1,75 @@
+package digest
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/mail"
+	"time"
+
+	"hello-go/internal/config"
+	"hello-go/internal/models"
+)
+
+// Mailer는 수신자별 설정에 맞춰 다이제스트를 만들고 보냅니다.
+type Mailer struct {
+	cfg      config.DigestConfig
+	renderer *Renderer
+	sender   Sender
+}
+
+// NewMailer는 새로운 Mailer 인스턴스를 생성합니다.
+func NewMailer(cfg config.DigestConfig, sender Sender) (*Mailer, error) {
+	if cfg.From == "" {
+		return nil, errors.New("digest.from이 비어 있습니다")
+	}
+	renderer, err := NewRenderer(cfg.Subject)
+	if err != nil {
+		return nil, err
+	}
+	return &Mailer{cfg: cfg, renderer: renderer, sender: sender}, nil
+}
+
+// Window는 설정된 다이제스트 기간을 반환합니다.
+func (m *Mailer) Window() time.Duration {
+	if m.cfg.WindowDays > 0 {
+		return time.Duration(m.cfg.WindowDays) * 24 * time.Hour
+	}
+	return defaultWindow
+}
+
+// Send는 수신자마다 선호 소스에 맞는 다이제스트를 보냅니다. 받을 포스트가 없는 수신자는 건너뜁니다.
+func (m *Mailer) Send(posts []models.BlogPost, until time.Time) error {
+	from, err := mail.ParseAddress(m.cfg.From)
+	if err != nil {
+		return fmt.Errorf("발신자 주소 파싱 실패: %w", err)
+	}
+
+	var errs []error
+	for _, recipient := range m.cfg.Recipients {
+		selected := Select(posts, until, m.Window(), recipient.Sources)
+		if len(selected) == 0 {
+			log.Printf("📭 %s: 다이제스트에 포함할 포스트가 없습니다.", recipient.Email)
+			continue
+		}
+
+		content, err := m.renderer.Render(New(selected, until, m.Window()))
+		if err != nil {
+			return err
+		}
+
+		to := mail.Address{Name: recipient.Name, Address: recipient.Email}
+		msg, err := BuildMessage(*from, to, content, time.Now())
+		if err != nil {
+			return err
+		}
+
+		if err := m.sender.Send(from.Address, []string{recipient.Email}, msg); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", recipient.Email, err))
+			continue
+		}
+		log.Printf("📧 %s: 다이제스트 전송 완료 (%d개 포스트)", recipient.Email, len(selected))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,80 @@
+package digest
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// BuildMessage는 텍스트와 HTML 본문을 multipart/alternative로 담은 RFC 5322 메시지를 생성합니다.
+func BuildMessage(from, to mail.Address, content Content, date time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := multipart.NewWriter(&buf)
+
+	domain := "localhost"
+	if at := strings.LastIndex(from.Address, "@"); at >= 0 {
+		domain = from.Address[at+1:]
+	}
+	id := make([]byte, 12)
+	_, _ = rand.Read(id)
+
+	headers := []struct{ key, value string }{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.BEncoding.Encode("UTF-8", content.Subject)},
+		{"Date", date.Format(time.RFC1123Z)},
+		{"Message-ID", fmt.Sprintf("<%s@%s>", hex.EncodeToString(id), domain)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", fmt.Sprintf("multipart/alternative; boundary=%q", writer.Boundary())},
+	}
+	var header bytes.Buffer
+	for _, h := range headers {
+		fmt.Fprintf(&header, "%s: %s\r\n", h.key, h.value)
+	}
+	header.WriteString("\r\n")
+
+	// 메일 클라이언트는 마지막 파트를 우선하므로 텍스트, HTML 순서로 작성
+	parts := []struct{ contentType, body string }{
+		{"text/plain; charset=UTF-8", content.Text},
+		{"text/html; charset=UTF-8", content.HTML},
+	}
+	for _, p := range parts {
+		part, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {p.contentType},
+			"Content-Transfer-Encoding": {"base64"},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("메일 파트 생성 실패: %w", err)
+		}
+		if _, err := part.Write(wrapBase64(p.body)); err != nil {
+			return nil, fmt.Errorf("메일 파트 쓰기 실패: %w", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("메일 본문 마무리 실패: %w", err)
+	}
+
+	return append(header.Bytes(), buf.Bytes()...), nil
+}
+
+// wrapBase64는 본문을 base64로 인코딩하고 76자마다 줄바꿈합니다. (RFC 2045)
+func wrapBase64(body string) []byte {
+	encoded := base64.StdEncoding.EncodeToString([]byte(body))
+	var out bytes.Buffer
+	for len(encoded) > 76 {
+		out.WriteString(encoded[:76])
+		out.WriteString("\r\n")
+		encoded = encoded[76:]
+	}
+	out.WriteString(encoded)
+	out.WriteString("\r\n")
+	return out.Bytes()
+}
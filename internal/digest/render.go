@@ -0,0 +1,111 @@
+package digest
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	texttemplate "text/template"
+)
+
+const defaultSubject = `[기술 블로그 다이제스트] {{.From.Format "1/2"}} ~ {{.Until.Format "1/2"}} 새 글 {{.Count}}개`
+
+const textTemplate = `개발자들의 이야기 모음집 - 주간 다이제스트
+{{.From.Format "2006-01-02"}} ~ {{.Until.Format "2006-01-02"}} 동안 발행된 {{.Count}}개의 글입니다.
+{{range .Groups}}
+■ {{.Source}} ({{.Count}}개)
+{{- range .Categories}}
+  [{{.Category}}]
+{{- range .Posts}}
+  - {{.Title}} ({{.PublishedAt.Format "01-02"}}{{if .Author}}, {{.Author}}{{end}})
+    {{.URL}}
+{{- end}}
+{{- end}}
+{{end}}`
+
+const htmlTemplate = `<!DOCTYPE html>
+<html lang="ko">
+<head>
+    <meta charset="UTF-8">
+    <title>기술 블로그 다이제스트</title>
+</head>
+<body style="margin:0; padding:20px; background-color:#f8f9fa; font-family:-apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, sans-serif; color:#333;">
+    <div style="max-width:640px; margin:0 auto; background:white; border-radius:15px; overflow:hidden;">
+        <div style="padding:30px; background:linear-gradient(135deg, #667eea 0%, #764ba2 100%); color:white; text-align:center;">
+            <h1 style="margin:0 0 10px; font-size:24px;">개발자들의 이야기 모음집</h1>
+            <p style="margin:0; opacity:0.9;">{{.From.Format "2006-01-02"}} ~ {{.Until.Format "2006-01-02"}} 동안 발행된 {{.Count}}개의 글</p>
+        </div>
+        <div style="padding:20px 30px;">
+            {{range .Groups}}
+            <h2 style="font-size:18px; color:#667eea; border-bottom:2px solid #f0f0f0; padding-bottom:8px;">{{.Source}} <span style="color:#999; font-size:14px;">{{.Count}}개</span></h2>
+            {{range .Categories}}
+            <h3 style="font-size:14px; color:#666; margin:15px 0 8px;">{{.Category}}</h3>
+            <ul style="margin:0; padding-left:20px;">
+                {{range .Posts}}
+                <li style="margin-bottom:10px;">
+                    <a href="{{.URL}}" style="color:#333; font-weight:600; text-decoration:none;">{{.Title}}</a>
+                    <div style="font-size:12px; color:#999;">{{.PublishedAt.Format "01-02"}}{{if .Author}} · {{.Author}}{{end}}</div>
+                    {{if .Summary}}<div style="font-size:13px; color:#666;">{{.Summary}}</div>{{end}}
+                </li>
+                {{end}}
+            </ul>
+            {{end}}
+            {{end}}
+        </div>
+    </div>
+</body>
+</html>`
+
+// Content는 렌더링된 다이제스트 이메일 내용입니다.
+type Content struct {
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// Renderer는 다이제스트를 제목, 텍스트, HTML로 렌더링합니다.
+type Renderer struct {
+	subject *texttemplate.Template
+	text    *texttemplate.Template
+	html    *htmltemplate.Template
+}
+
+// NewRenderer는 새로운 Renderer 인스턴스를 생성합니다. subject가 비어 있으면 기본 제목을 사용합니다.
+func NewRenderer(subject string) (*Renderer, error) {
+	if subject == "" {
+		subject = defaultSubject
+	}
+
+	r := &Renderer{}
+	var err error
+	if r.subject, err = texttemplate.New("subject").Parse(subject); err != nil {
+		return nil, fmt.Errorf("제목 템플릿 파싱 실패: %w", err)
+	}
+	if r.text, err = texttemplate.New("text").Parse(textTemplate); err != nil {
+		return nil, fmt.Errorf("텍스트 템플릿 파싱 실패: %w", err)
+	}
+	if r.html, err = htmltemplate.New("html").Parse(htmlTemplate); err != nil {
+		return nil, fmt.Errorf("HTML 템플릿 파싱 실패: %w", err)
+	}
+	return r, nil
+}
+
+// Render는 다이제스트를 렌더링합니다.
+func (r *Renderer) Render(d Digest) (Content, error) {
+	var subject, text, html bytes.Buffer
+	if err := r.subject.Execute(&subject, d); err != nil {
+		return Content{}, fmt.Errorf("제목 생성 실패: %w", err)
+	}
+	if err := r.text.Execute(&text, d); err != nil {
+		return Content{}, fmt.Errorf("텍스트 본문 생성 실패: %w", err)
+	}
+	if err := r.html.Execute(&html, d); err != nil {
+		return Content{}, fmt.Errorf("HTML 본문 생성 실패: %w", err)
+	}
+
+	return Content{
+		Subject: strings.TrimSpace(subject.String()),
+		Text:    text.String(),
+		HTML:    html.String(),
+	}, nil
+}
@@ -0,0 +1,72 @@
+package digest
+
+import (
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"hello-go/internal/config"
+)
+
+// Sender는 완성된 메일 메시지를 보내기 위한 인터페이스입니다.
+type Sender interface {
+	Send(from string, to []string, msg []byte) error
+}
+
+// SMTPSender는 SMTP 서버로 메일을 보냅니다.
+type SMTPSender struct {
+	addr string
+	auth smtp.Auth
+}
+
+// NewSMTPSender는 새로운 SMTPSender 인스턴스를 생성합니다. 사용자 이름이 있으면 PLAIN 인증을 사용합니다.
+func NewSMTPSender(cfg config.SMTPConfig) *SMTPSender {
+	port := cfg.Port
+	if port == 0 {
+		port = 587
+	}
+
+	sender := &SMTPSender{addr: net.JoinHostPort(cfg.Host, strconv.Itoa(port))}
+	if cfg.Username != "" {
+		sender.auth = smtp.PlainAuth("", cfg.Username, cfg.Password, cfg.Host)
+	}
+	return sender
+}
+
+// Send는 SMTP로 메일을 보냅니다.
+func (s *SMTPSender) Send(from string, to []string, msg []byte) error {
+	if err := smtp.SendMail(s.addr, s.auth, from, to, msg); err != nil {
+		return fmt.Errorf("SMTP 전송 실패: %w", err)
+	}
+	return nil
+}
+
+// DryRunSender는 메일을 보내지 않고 디렉터리에 .eml 파일로 저장합니다.
+type DryRunSender struct {
+	dir string
+}
+
+// NewDryRunSender는 새로운 DryRunSender 인스턴스를 생성합니다.
+func NewDryRunSender(dir string) *DryRunSender {
+	return &DryRunSender{dir: dir}
+}
+
+// Send는 수신자별 .eml 파일을 저장합니다.
+func (s *DryRunSender) Send(_ string, to []string, msg []byte) error {
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return fmt.Errorf("디렉터리 생성 실패: %w", err)
+	}
+
+	for _, recipient := range to {
+		name := fmt.Sprintf("%s-%s.eml", time.Now().Format("20060102"), strings.NewReplacer("@", "_at_", "/", "_").Replace(recipient))
+		if err := os.WriteFile(filepath.Join(s.dir, name), msg, 0o644); err != nil {
+			return fmt.Errorf(".eml 파일 쓰기 실패: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"hello-go/internal/crawlers"
+	"hello-go/internal/storage"
+)
+
+// Checker는 크롤러를 실행하여 소스별 필드 완성도를 기대치와 비교합니다.
+type Checker struct {
+	// Expectations는 소스 ID별 기대치입니다. 없는 소스는 DefaultExpectation을 사용합니다.
+	Expectations map[string]Expectation
+	// Tolerance는 기대치 대비 허용하는 완성도 하락폭(0~1)입니다.
+	Tolerance float64
+}
+
+// Run은 크롤러들을 병렬로 실행하고 헬스체크 보고서를 반환합니다.
+func (c Checker) Run(entries []crawlers.Entry, since time.Time) Report {
+	report := Report{CheckedAt: time.Now(), Sources: make([]SourceHealth, len(entries))}
+
+	var wg sync.WaitGroup
+	for i, entry := range entries {
+		wg.Add(1)
+		go func(i int, entry crawlers.Entry) {
+			defer wg.Done()
+			report.Sources[i] = c.check(entry, since)
+		}(i, entry)
+	}
+	wg.Wait()
+
+	report.Healthy = true
+	for _, source := range report.Sources {
+		if !source.Healthy {
+			report.Healthy = false
+		}
+	}
+	return report
+}
+
+func (c Checker) check(entry crawlers.Entry, since time.Time) SourceHealth {
+	crawler := entry.NewCrawler(crawlers.Options{Since: since})
+	source := SourceHealth{ID: entry.ID, Name: crawler.GetSource().Name}
+
+	log.Printf("🩺 %s 헬스체크 시작...", source.Name)
+	crawledAt := time.Now()
+	posts, err := crawler.Crawl()
+	if err != nil {
+		source.Error = err.Error()
+	}
+	source.Posts = len(posts)
+
+	source.Completeness, source.Issues = Inspect(posts, crawledAt)
+	source.Score = score(source.Completeness)
+	if len(source.Issues) > maxIssues {
+		source.Issues = source.Issues[:maxIssues]
+	}
+
+	expected, ok := c.Expectations[entry.ID]
+	if !ok {
+		expected = DefaultExpectation
+	}
+	evaluate(&source, expected, c.Tolerance)
+
+	if source.Healthy {
+		log.Printf("✅ %s: %d개 포스트, 점수 %.2f", source.Name, source.Posts, source.Score)
+	} else {
+		log.Printf("❌ %s: %v", source.Name, source.Failures)
+	}
+	return source
+}
+
+// SaveReport는 보고서를 저장소에 저장합니다.
+func SaveReport(s storage.Storage, report Report) error {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return fmt.Errorf("보고서 인코딩 실패: %w", err)
+	}
+	return s.Write(ReportKey, data)
+}
@@ -0,0 +1,97 @@
+package health
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+
+	"hello-go/internal/storage"
+)
+
+// ExpectationsKey는 소스별 기대치를 저장하는 키입니다.
+const ExpectationsKey = "health/expectations.json"
+
+// ReportKey는 마지막 헬스체크 보고서를 저장하는 키입니다.
+const ReportKey = "health/latest.json"
+
+// Expectation은 소스가 정상일 때의 최소 포스트 수와 필드별 완성도입니다.
+type Expectation struct {
+	MinPosts     int                `json:"min_posts"`
+	Completeness map[string]float64 `json:"completeness"`
+}
+
+// DefaultExpectation은 저장된 기대치가 없는 소스에 적용됩니다. 제목과 URL은 모든 포스트에 있어야 합니다.
+var DefaultExpectation = Expectation{
+	MinPosts:     1,
+	Completeness: map[string]float64{FieldTitle: 1, FieldURL: 1},
+}
+
+// LoadExpectations는 저장소에서 소스 ID별 기대치를 읽습니다. 저장된 기대치가 없으면 빈 맵을 반환합니다.
+func LoadExpectations(s storage.Storage) (map[string]Expectation, error) {
+	data, err := s.Read(ExpectationsKey)
+	if errors.Is(err, storage.ErrNotFound) {
+		return map[string]Expectation{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var expectations map[string]Expectation
+	if err := json.Unmarshal(data, &expectations); err != nil {
+		return nil, fmt.Errorf("기대치 파싱 실패: %w", err)
+	}
+	return expectations, nil
+}
+
+// SaveExpectations는 보고서의 정상 소스 결과를 새 기대치로 저장합니다.
+// 실패했거나 기대치에 못 미친 소스는 깨진 상태가 기준이 되지 않도록 기존 기대치를 유지합니다.
+func SaveExpectations(s storage.Storage, report Report, previous map[string]Expectation) (map[string]Expectation, error) {
+	expectations := make(map[string]Expectation, len(previous))
+	for id, expectation := range previous {
+		expectations[id] = expectation
+	}
+	for _, source := range report.Sources {
+		if !source.Healthy || source.Posts == 0 {
+			continue
+		}
+		completeness := make(map[string]float64, len(source.Completeness))
+		for field, value := range source.Completeness {
+			// 소수점 둘째 자리에서 내림하여 작은 변동은 허용
+			completeness[field] = math.Floor(value*100) / 100
+		}
+		expectations[source.ID] = Expectation{MinPosts: 1, Completeness: completeness}
+	}
+
+	data, err := json.MarshalIndent(expectations, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("기대치 인코딩 실패: %w", err)
+	}
+	if err := s.Write(ExpectationsKey, data); err != nil {
+		return nil, err
+	}
+	return expectations, nil
+}
+
+// evaluate는 소스 결과를 기대치와 비교하여 실패 사유를 채웁니다. tolerance만큼의 완성도 하락은 허용합니다.
+func evaluate(source *SourceHealth, expected Expectation, tolerance float64) {
+	source.Expected = expected
+	if source.Error != "" {
+		source.Failures = append(source.Failures, "크롤링 실패: "+source.Error)
+	}
+	if source.Posts < expected.MinPosts {
+		source.Failures = append(source.Failures, fmt.Sprintf("포스트 수 %d개가 최소 %d개보다 적습니다", source.Posts, expected.MinPosts))
+	}
+	if source.Posts > 0 {
+		for _, field := range Fields {
+			want, ok := expected.Completeness[field]
+			if !ok {
+				continue
+			}
+			if got := source.Completeness[field]; got < want-tolerance {
+				source.Failures = append(source.Failures, fmt.Sprintf("%s 완성도 %.0f%%가 기대치 %.0f%%보다 낮습니다", field, got*100, want*100))
+			}
+		}
+	}
+	source.Healthy = len(source.Failures) == 0
+}
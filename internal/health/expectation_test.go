@@ -0,0 +1,38 @@
+package health
+
+import (
+	"testing"
+
+	"hello-go/internal/storage"
+)
+
+func TestSaveExpectationsKeepsUnhealthySources(t *testing.T) {
+	previous := map[string]Expectation{
+		"broken": {MinPosts: 1, Completeness: map[string]float64{FieldTitle: 1, FieldImage: 0.9}},
+	}
+	report := Report{Sources: []SourceHealth{
+		{ID: "ok", Healthy: true, Posts: 3, Completeness: map[string]float64{FieldTitle: 1, FieldImage: 0.666}},
+		// 선택자가 깨져 이미지 완성도가 떨어진 소스
+		{ID: "broken", Healthy: false, Posts: 3, Completeness: map[string]float64{FieldTitle: 1, FieldImage: 0.1}},
+		{ID: "failed", Healthy: false, Error: "목록 페이지 500"},
+	}}
+
+	store := storage.NewFileStorage(t.TempDir())
+	if _, err := SaveExpectations(store, report, previous); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := LoadExpectations(store)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := saved["ok"].Completeness[FieldImage]; got != 0.66 {
+		t.Errorf("ok 이미지 완성도 = %v, want 0.66", got)
+	}
+	if got := saved["broken"].Completeness[FieldImage]; got != 0.9 {
+		t.Errorf("비정상 소스의 기대치가 바뀜: 이미지 완성도 %v", got)
+	}
+	if _, ok := saved["failed"]; ok {
+		t.Error("실패한 소스의 기대치가 저장됨")
+	}
+}
@@ -0,0 +1,106 @@
+package health
+
+import (
+	"strings"
+	"time"
+
+	"hello-go/internal/models"
+)
+
+// 포스트마다 확인하는 필수 필드 목록입니다.
+const (
+	FieldTitle = "title"
+	FieldURL   = "url"
+	FieldDate  = "date"
+	FieldImage = "image"
+)
+
+// Fields는 완성도를 계산하는 필드 목록입니다.
+var Fields = []string{FieldTitle, FieldURL, FieldDate, FieldImage}
+
+// maxIssues는 소스별 보고서에 담을 최대 문제 포스트 수입니다.
+const maxIssues = 20
+
+// PostIssue는 필수 필드가 빠진 포스트입니다.
+type PostIssue struct {
+	Title   string   `json:"title"`
+	URL     string   `json:"url"`
+	Missing []string `json:"missing"`
+}
+
+// SourceHealth는 소스 하나의 헬스체크 결과입니다.
+type SourceHealth struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name"`
+	Healthy      bool               `json:"healthy"`
+	Posts        int                `json:"posts"`
+	Error        string             `json:"error,omitempty"`
+	Completeness map[string]float64 `json:"completeness"`
+	Score        float64            `json:"score"`
+	Expected     Expectation        `json:"expected"`
+	Failures     []string           `json:"failures,omitempty"`
+	Issues       []PostIssue        `json:"issues,omitempty"`
+}
+
+// Report는 전체 헬스체크 결과입니다.
+type Report struct {
+	CheckedAt time.Time      `json:"checked_at"`
+	Healthy   bool           `json:"healthy"`
+	Sources   []SourceHealth `json:"sources"`
+}
+
+// missingFields는 포스트에서 빠진 필수 필드 목록을 반환합니다.
+// 크롤링 시작 이후의 날짜는 파싱 실패 시 넣는 현재 시각으로 보고 실제 날짜가 아닌 것으로 판단합니다.
+func missingFields(post models.BlogPost, crawledAt time.Time) []string {
+	var missing []string
+	if strings.TrimSpace(post.Title) == "" {
+		missing = append(missing, FieldTitle)
+	}
+	if !strings.HasPrefix(post.URL, "http://") && !strings.HasPrefix(post.URL, "https://") {
+		missing = append(missing, FieldURL)
+	}
+	if post.PublishedAt.IsZero() || !post.PublishedAt.Before(crawledAt) {
+		missing = append(missing, FieldDate)
+	}
+	if strings.TrimSpace(post.Image) == "" {
+		missing = append(missing, FieldImage)
+	}
+	return missing
+}
+
+// Inspect는 포스트 목록의 필드별 완성도(0~1)와 필드가 빠진 포스트 목록을 반환합니다.
+func Inspect(posts []models.BlogPost, crawledAt time.Time) (map[string]float64, []PostIssue) {
+	present := make(map[string]int)
+	var issues []PostIssue
+	for _, post := range posts {
+		missing := missingFields(post, crawledAt)
+		for _, field := range Fields {
+			present[field]++
+		}
+		for _, field := range missing {
+			present[field]--
+		}
+		if len(missing) > 0 {
+			issues = append(issues, PostIssue{Title: post.Title, URL: post.URL, Missing: missing})
+		}
+	}
+
+	completeness := make(map[string]float64, len(Fields))
+	for _, field := range Fields {
+		if len(posts) > 0 {
+			completeness[field] = float64(present[field]) / float64(len(posts))
+		} else {
+			completeness[field] = 0
+		}
+	}
+	return completeness, issues
+}
+
+// score는 필드별 완성도의 평균입니다.
+func score(completeness map[string]float64) float64 {
+	var sum float64
+	for _, field := range Fields {
+		sum += completeness[field]
+	}
+	return sum / float64(len(Fields))
+}
@@ -0,0 +1,240 @@
+// Package httpcache는 크롤러의 GET 응답을 저장소에 보관하고 조건부 요청으로 재검증하는 HTTP 캐시입니다.
+// ETag 또는 Last-Modified가 있는 200 응답만 저장하며, 다음 요청에 If-None-Match/If-Modified-Since를 보내
+// 304 응답을 받으면 저장된 본문을 그대로 사용합니다.
+package httpcache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"hello-go/internal/models"
+	"hello-go/internal/storage"
+)
+
+// Prefix는 캐시 항목을 저장하는 키 접두사입니다.
+const Prefix = "httpcache/"
+
+// Entry는 저장된 응답 하나입니다.
+type Entry struct {
+	URL          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+	ContentType  string    `json:"content_type,omitempty"`
+	Body         []byte    `json:"body"`
+	StoredAt     time.Time `json:"stored_at"`
+}
+
+// Cache는 저장소에 응답을 보관하고 소스별 적중률을 측정합니다.
+type Cache struct {
+	store storage.Storage
+
+	mu      sync.Mutex
+	windows map[*Window]bool
+}
+
+// New는 store를 사용하는 Cache를 생성합니다.
+func New(store storage.Storage) *Cache {
+	return &Cache{store: store, windows: make(map[*Window]bool)}
+}
+
+var (
+	defaultMu    sync.RWMutex
+	defaultCache *Cache
+)
+
+// Default는 크롤러가 사용하는 프로세스 전체 Cache를 반환합니다. 설정하지 않았으면 nil입니다.
+func Default() *Cache {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultCache
+}
+
+// Configure는 프로세스 전체 Cache를 설정합니다. store가 nil이면 캐시를 끕니다. 크롤러를 생성하기 전에 호출해야 합니다.
+func Configure(store storage.Storage) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	if store == nil {
+		defaultCache = nil
+		return
+	}
+	defaultCache = New(store)
+}
+
+// key는 URL의 캐시 키를 반환합니다.
+func key(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return Prefix + hex.EncodeToString(sum[:]) + ".json"
+}
+
+// load는 URL의 저장된 응답을 읽습니다. 없거나 읽을 수 없으면 false를 반환합니다.
+func (c *Cache) load(url string) (Entry, bool) {
+	data, err := c.store.Read(key(url))
+	if err != nil {
+		if !errors.Is(err, storage.ErrNotFound) {
+			log.Printf("HTTP 캐시 읽기 실패 (%s): %v", url, err)
+		}
+		return Entry{}, false
+	}
+	var entry Entry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.URL != url {
+		return Entry{}, false
+	}
+	return entry, true
+}
+
+// save는 응답을 저장합니다. 실패해도 요청은 계속 진행되므로 로그만 남깁니다.
+func (c *Cache) save(entry Entry) {
+	data, err := json.Marshal(entry)
+	if err == nil {
+		err = c.store.Write(key(entry.URL), data)
+	}
+	if err != nil {
+		log.Printf("HTTP 캐시 저장 실패 (%s): %v", entry.URL, err)
+	}
+}
+
+// Transport는 base(nil이면 http.DefaultTransport)로 보내는 GET 요청에 캐시를 적용합니다.
+// source는 적중률을 집계할 소스 ID입니다.
+func (c *Cache) Transport(base http.RoundTripper, source string) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{cache: c, base: base, source: source}
+}
+
+type transport struct {
+	cache  *Cache
+	base   http.RoundTripper
+	source string
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// 호출한 쪽이 직접 조건부 요청을 보내면 관여하지 않음
+	if req.Method != http.MethodGet || req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		return t.base.RoundTrip(req)
+	}
+
+	url := req.URL.String()
+	entry, cached := t.cache.load(url)
+	if cached {
+		req = req.Clone(req.Context())
+		if entry.ETag != "" {
+			req.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			req.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if cached && resp.StatusCode == http.StatusNotModified {
+		resp.Body.Close()
+		t.cache.record(t.source, true)
+		return entry.response(req, resp), nil
+	}
+	t.cache.record(t.source, false)
+
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if resp.StatusCode != http.StatusOK || (etag == "" && lastModified == "") {
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("응답 본문 읽기 실패: %w", err)
+	}
+	t.cache.save(Entry{
+		URL:          url,
+		ETag:         etag,
+		LastModified: lastModified,
+		ContentType:  resp.Header.Get("Content-Type"),
+		Body:         body,
+		StoredAt:     time.Now(),
+	})
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// response는 304 응답을 저장된 본문의 200 응답으로 바꿉니다.
+func (e Entry) response(req *http.Request, notModified *http.Response) *http.Response {
+	header := notModified.Header.Clone()
+	if e.ContentType != "" {
+		header.Set("Content-Type", e.ContentType)
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         notModified.Proto,
+		ProtoMajor:    notModified.ProtoMajor,
+		ProtoMinor:    notModified.ProtoMinor,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.Body)),
+		ContentLength: int64(len(e.Body)),
+		Request:       req,
+	}
+}
+
+// Window는 Measure부터 Stop까지의 소스별 캐시 적중을 측정합니다.
+type Window struct {
+	cache   *Cache
+	sources map[string]*models.CacheStats
+}
+
+// Measure는 적중률 측정을 시작합니다. 여러 구간을 동시에 측정할 수 있습니다.
+func (c *Cache) Measure() *Window {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w := &Window{cache: c, sources: make(map[string]*models.CacheStats)}
+	c.windows[w] = true
+	return w
+}
+
+// record는 요청 하나의 적중 여부를 측정 중인 구간에 기록합니다.
+func (c *Cache) record(source string, hit bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for w := range c.windows {
+		stats, ok := w.sources[source]
+		if !ok {
+			stats = &models.CacheStats{Source: source}
+			w.sources[source] = stats
+		}
+		stats.Requests++
+		if hit {
+			stats.Hits++
+		}
+	}
+}
+
+// Stop은 측정을 끝내고 소스별 적중률을 소스 ID 순으로 반환합니다.
+func (w *Window) Stop() []models.CacheStats {
+	c := w.cache
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.windows, w)
+
+	result := make([]models.CacheStats, 0, len(w.sources))
+	for _, stats := range w.sources {
+		stats.HitRatio = float64(stats.Hits) / float64(stats.Requests)
+		result = append(result, *stats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Source < result[j].Source
+	})
+	return result
+}
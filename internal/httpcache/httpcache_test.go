@@ -0,0 +1,244 @@
+package httpcache
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"hello-go/internal/storage"
+)
+
+// memStorage는 테스트용 메모리 저장소입니다.
+type memStorage struct {
+	mu   sync.Mutex
+	data map[string][]byte
+}
+
+func newMemStorage() *memStorage {
+	return &memStorage{data: make(map[string][]byte)}
+}
+
+func (m *memStorage) Read(key string) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	data, ok := m.data[key]
+	if !ok {
+		return nil, storage.ErrNotFound
+	}
+	return data, nil
+}
+
+func (m *memStorage) Write(key string, data []byte) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.data[key] = data
+	return nil
+}
+
+func (m *memStorage) List(prefix string) ([]string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var keys []string
+	for key := range m.data {
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (m *memStorage) Delete(key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.data, key)
+	return nil
+}
+
+// origin은 ETag와 Last-Modified로 재검증하는 테스트 서버입니다.
+type origin struct {
+	etag         string
+	lastModified string
+	body         string
+
+	mu       sync.Mutex
+	requests []http.Header
+	served   atomic.Int32
+}
+
+func (o *origin) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	o.mu.Lock()
+	o.requests = append(o.requests, r.Header.Clone())
+	o.mu.Unlock()
+
+	if o.etag != "" {
+		w.Header().Set("ETag", o.etag)
+	}
+	if o.lastModified != "" {
+		w.Header().Set("Last-Modified", o.lastModified)
+	}
+	if (o.etag != "" && r.Header.Get("If-None-Match") == o.etag) ||
+		(o.lastModified != "" && r.Header.Get("If-Modified-Since") == o.lastModified) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	o.served.Add(1)
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	io.WriteString(w, o.body)
+}
+
+func (o *origin) lastRequest() http.Header {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.requests[len(o.requests)-1]
+}
+
+func newTestClient(t *testing.T, o *origin) (*http.Client, *Cache, string) {
+	t.Helper()
+	server := httptest.NewServer(o)
+	t.Cleanup(server.Close)
+	cache := New(newMemStorage())
+	return &http.Client{Transport: cache.Transport(nil, "fixture")}, cache, server.URL + "/posts"
+}
+
+func get(t *testing.T, client *http.Client, url string, header http.Header) (*http.Response, string) {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, string(body)
+}
+
+func TestSendsConditionalHeaders(t *testing.T) {
+	o := &origin{etag: `"v1"`, lastModified: "Wed, 01 Jan 2025 00:00:00 GMT", body: "<html>목록</html>"}
+	client, _, url := newTestClient(t, o)
+
+	get(t, client, url, nil)
+	if h := o.lastRequest(); h.Get("If-None-Match") != "" || h.Get("If-Modified-Since") != "" {
+		t.Errorf("첫 요청에 조건부 헤더: %v", h)
+	}
+
+	get(t, client, url, nil)
+	h := o.lastRequest()
+	if h.Get("If-None-Match") != `"v1"` || h.Get("If-Modified-Since") != "Wed, 01 Jan 2025 00:00:00 GMT" {
+		t.Errorf("재검증 요청 헤더: %v", h)
+	}
+}
+
+func TestRewritesNotModifiedToStoredBody(t *testing.T) {
+	o := &origin{etag: `"v1"`, body: "<html>목록</html>"}
+	client, _, url := newTestClient(t, o)
+
+	get(t, client, url, nil)
+	resp, body := get(t, client, url, nil)
+
+	if resp.StatusCode != http.StatusOK || body != "<html>목록</html>" {
+		t.Errorf("status = %d, body = %q", resp.StatusCode, body)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "text/html; charset=utf-8" {
+		t.Errorf("Content-Type = %q", ct)
+	}
+	if resp.ContentLength != int64(len(body)) {
+		t.Errorf("ContentLength = %d", resp.ContentLength)
+	}
+	if n := o.served.Load(); n != 1 {
+		t.Errorf("본문을 %d번 받음, want 1", n)
+	}
+
+	// 원본이 바뀌면 새 본문을 받고 다시 저장
+	o.etag, o.body = `"v2"`, "<html>새 목록</html>"
+	if _, body := get(t, client, url, nil); body != "<html>새 목록</html>" {
+		t.Errorf("변경 후 body = %q", body)
+	}
+	get(t, client, url, nil)
+	if h := o.lastRequest(); h.Get("If-None-Match") != `"v2"` {
+		t.Errorf("변경 후 If-None-Match = %q", h.Get("If-None-Match"))
+	}
+}
+
+func TestSkipsResponsesWithoutValidators(t *testing.T) {
+	o := &origin{body: "<html>목록</html>"}
+	client, _, url := newTestClient(t, o)
+
+	get(t, client, url, nil)
+	get(t, client, url, nil)
+	if h := o.lastRequest(); h.Get("If-None-Match") != "" || h.Get("If-Modified-Since") != "" {
+		t.Errorf("검증자 없는 응답을 재검증함: %v", h)
+	}
+}
+
+func TestBypassesCallerConditionalRequests(t *testing.T) {
+	o := &origin{etag: `"v1"`, body: "<html>목록</html>"}
+	client, _, url := newTestClient(t, o)
+	get(t, client, url, nil)
+
+	// 호출한 쪽이 보낸 조건부 요청의 304는 그대로 전달
+	resp, body := get(t, client, url, http.Header{"If-None-Match": {`"v1"`}})
+	if resp.StatusCode != http.StatusNotModified || body != "" {
+		t.Errorf("status = %d, body = %q, want 304", resp.StatusCode, body)
+	}
+
+	resp, _ = get(t, client, url, http.Header{"If-None-Match": {`"old"`}})
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", resp.StatusCode)
+	}
+	if h := o.lastRequest(); h.Get("If-None-Match") != `"old"` {
+		t.Errorf("호출한 쪽의 If-None-Match가 바뀜: %q", h.Get("If-None-Match"))
+	}
+}
+
+func TestWindowCountsHits(t *testing.T) {
+	o := &origin{etag: `"v1"`, body: "<html>목록</html>"}
+	server := httptest.NewServer(o)
+	defer server.Close()
+
+	cache := New(newMemStorage())
+	toss := &http.Client{Transport: cache.Transport(nil, "toss")}
+	kakao := &http.Client{Transport: cache.Transport(nil, "kakao")}
+
+	get(t, toss, server.URL+"/toss", nil)
+	window := cache.Measure()
+	get(t, toss, server.URL+"/toss", nil)  // 적중
+	get(t, toss, server.URL+"/other", nil) // 처음 요청
+	get(t, kakao, server.URL+"/kakao", nil)
+
+	inner := cache.Measure()
+	get(t, kakao, server.URL+"/kakao", nil) // 적중
+	innerStats := inner.Stop()
+	stats := window.Stop()
+
+	// 측정이 끝난 뒤의 요청은 집계하지 않음
+	get(t, toss, server.URL+"/toss", nil)
+
+	if len(stats) != 2 {
+		t.Fatalf("stats = %+v", stats)
+	}
+	kakaoStats, tossStats := stats[0], stats[1]
+	if kakaoStats.Source != "kakao" || kakaoStats.Requests != 2 || kakaoStats.Hits != 1 || kakaoStats.HitRatio != 0.5 {
+		t.Errorf("kakao = %+v", kakaoStats)
+	}
+	if tossStats.Source != "toss" || tossStats.Requests != 2 || tossStats.Hits != 1 || tossStats.HitRatio != 0.5 {
+		t.Errorf("toss = %+v", tossStats)
+	}
+	if len(innerStats) != 1 || innerStats[0].Source != "kakao" || innerStats[0].Requests != 1 || innerStats[0].HitRatio != 1 {
+		t.Errorf("겹친 구간 = %+v", innerStats)
+	}
+}
@@ -0,0 +1,321 @@
+// Package images는 포스트 썸네일을 내려받아 검증하고 축소한 뒤 사이트와 함께 저장하여,
+// 외부 이미지를 직접 링크하지 않고 자체 사본을 사용하게 합니다.
+package images
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif" // GIF 디코더 등록
+	"image/jpeg"
+	_ "image/png" // PNG 디코더 등록
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"hello-go/internal/models"
+	"hello-go/internal/scheduler"
+	"hello-go/internal/storage"
+)
+
+const (
+	// Prefix는 이미지 사본을 저장하는 키 접두사이며, 사이트 기준 상대 경로이기도 합니다.
+	Prefix = "images/"
+	// ManifestKey는 원본 주소와 사본의 대응을 저장하는 키입니다.
+	ManifestKey = Prefix + "manifest.json"
+
+	// 기본 설정값입니다.
+	DefaultWidth    = 640
+	DefaultMaxBytes = 5 << 20
+	DefaultWorkers  = 8
+
+	// maxPixels는 디코딩을 허용하는 최대 픽셀 수입니다. 작은 파일이 거대한 이미지로 풀리는 것을 막습니다.
+	maxPixels = 40_000_000
+	// jpegQuality는 사본의 JPEG 품질입니다.
+	jpegQuality = 80
+)
+
+// ErrUnsupported는 디코딩할 수 없는 형식(WebP, SVG 등)일 때 반환됩니다. 이 경우 원본 주소를 그대로 사용합니다.
+// WebP 디코더는 표준 라이브러리에 없고(golang.org/x/image/webp) 의존성을 늘리지 않기 위해 원본으로는 지원하지 않습니다.
+var ErrUnsupported = errors.New("지원하지 않는 이미지 형식")
+
+// Record는 원본 이미지 하나의 사본 정보입니다.
+type Record struct {
+	Key string `json:"key"`
+	// WebPKey는 무손실 WebP 사본의 키입니다. JPEG 사본보다 작을 때만 저장합니다.
+	WebPKey string `json:"webp_key,omitempty"`
+	Width   int    `json:"width"`
+	Height  int    `json:"height"`
+	// Color는 사본의 평균 색(#rrggbb)입니다.
+	Color     string    `json:"color,omitempty"`
+	FetchedAt time.Time `json:"fetched_at"`
+}
+
+// Processor는 포스트 이미지를 사본으로 바꿉니다. 이미 처리한 원본은 매니페스트로 확인하여 다시 내려받지 않습니다.
+type Processor struct {
+	client *http.Client
+	store  storage.Storage
+
+	// BaseURL은 사본 주소 앞에 붙이는 주소입니다. 비어 있으면 사이트 기준 상대 경로(images/...)를 사용합니다.
+	BaseURL  string
+	Width    int
+	MaxBytes int64
+	Workers  int
+
+	mu       sync.Mutex
+	manifest map[string]Record
+	loaded   bool
+	dirty    bool
+}
+
+// NewProcessor는 store에 사본을 저장하는 Processor를 생성합니다. 요청은 프로세스 전체 Scheduler를 거칩니다.
+func NewProcessor(store storage.Storage) *Processor {
+	return &Processor{
+		client: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: scheduler.Default().Transport(nil),
+		},
+		store:    store,
+		Width:    DefaultWidth,
+		MaxBytes: DefaultMaxBytes,
+		Workers:  DefaultWorkers,
+	}
+}
+
+// Apply는 포스트마다 이미지를 사본으로 바꾼 복사본을 반환하고 매니페스트를 저장합니다. 원본 슬라이스는 바꾸지 않습니다.
+// 내려받기나 검증에 실패한 포스트는 Image를 비워 자리 표시 이미지를 사용하게 하고,
+// 디코딩할 수 없는 형식은 원본 주소를 유지합니다.
+func (p *Processor) Apply(posts []models.BlogPost) ([]models.BlogPost, error) {
+	if err := p.loadManifest(); err != nil {
+		return nil, err
+	}
+
+	// 같은 원본은 한 번만 처리
+	var sources []string
+	seen := make(map[string]bool)
+	for _, post := range posts {
+		if p.eligible(post.Image) && !seen[post.Image] {
+			seen[post.Image] = true
+			sources = append(sources, post.Image)
+		}
+	}
+
+	results := make(map[string]hosted, len(sources))
+	var resultsMu sync.Mutex
+	work := make(chan string)
+	var wg sync.WaitGroup
+	for range max(p.Workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for src := range work {
+				h := p.host(src)
+				resultsMu.Lock()
+				results[src] = h
+				resultsMu.Unlock()
+			}
+		}()
+	}
+	for _, src := range sources {
+		work <- src
+	}
+	close(work)
+	wg.Wait()
+
+	rewritten := make([]models.BlogPost, len(posts))
+	copied, failed := 0, 0
+	for i, post := range posts {
+		if h, ok := results[post.Image]; ok {
+			if h.url == "" {
+				failed++
+			} else if h.url != post.Image {
+				copied++
+				post.ImageWidth, post.ImageHeight, post.ImageColor = h.record.Width, h.record.Height, h.record.Color
+				if h.record.WebPKey != "" {
+					post.ImageWebP = p.BaseURL + h.record.WebPKey
+				}
+			}
+			post.Image = h.url
+		}
+		rewritten[i] = post
+	}
+	log.Printf("🖼️  이미지 %d개 처리: 사본 %d개 사용, 실패 %d개", len(sources), copied, failed)
+
+	return rewritten, p.saveManifest()
+}
+
+// eligible은 처리할 원본 주소인지 확인합니다. 빈 값, data URI, 이미 사본인 주소는 제외합니다.
+func (p *Processor) eligible(src string) bool {
+	if !strings.HasPrefix(src, "http://") && !strings.HasPrefix(src, "https://") {
+		return false
+	}
+	return p.BaseURL == "" || !strings.HasPrefix(src, p.BaseURL)
+}
+
+// hosted는 원본 하나의 처리 결과입니다.
+type hosted struct {
+	url    string
+	record Record
+}
+
+// host는 원본의 사본 주소와 정보를 반환합니다. 실패하면 빈 주소를, 지원하지 않는 형식이면 원본 주소를 반환합니다.
+func (p *Processor) host(src string) hosted {
+	p.mu.Lock()
+	record, ok := p.manifest[src]
+	p.mu.Unlock()
+	if ok {
+		return hosted{url: p.BaseURL + record.Key, record: record}
+	}
+
+	record, err := p.fetch(src)
+	if errors.Is(err, ErrUnsupported) {
+		return hosted{url: src}
+	}
+	if err != nil {
+		log.Printf("이미지 처리 실패 (%s): %v", src, err)
+		return hosted{}
+	}
+
+	p.mu.Lock()
+	p.manifest[src] = record
+	p.dirty = true
+	p.mu.Unlock()
+	return hosted{url: p.BaseURL + record.Key, record: record}
+}
+
+// fetch는 원본을 내려받아 검증하고 축소한 JPEG 사본을 내용 해시 키로 저장합니다.
+// 무손실 WebP로도 인코딩하여 JPEG보다 작으면(단색 배경의 일러스트, 스크린숏 등) 함께 저장합니다.
+func (p *Processor) fetch(src string) (Record, error) {
+	resp, err := p.client.Get(src)
+	if err != nil {
+		return Record{}, fmt.Errorf("이미지 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return Record{}, fmt.Errorf("이미지 응답 오류: %d", resp.StatusCode)
+	}
+	if resp.ContentLength > p.MaxBytes {
+		return Record{}, fmt.Errorf("이미지가 너무 큼: %d바이트", resp.ContentLength)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, p.MaxBytes+1))
+	if err != nil {
+		return Record{}, fmt.Errorf("이미지 읽기 실패: %w", err)
+	}
+	if int64(len(data)) > p.MaxBytes {
+		return Record{}, fmt.Errorf("이미지가 너무 큼: %d바이트 초과", p.MaxBytes)
+	}
+
+	// CDN이 application/octet-stream 등으로 보내는 경우가 많아 헤더 대신 내용으로 형식을 판단
+	if sniffed := http.DetectContentType(data); !strings.HasPrefix(sniffed, "image/") {
+		contentType := resp.Header.Get("Content-Type")
+		// 내용으로 알 수 없는 이미지 형식(SVG 등)은 헤더를 믿고 원본 주소를 유지
+		if strings.HasPrefix(contentType, "image/") && !strings.HasPrefix(sniffed, "text/html") {
+			return Record{}, ErrUnsupported
+		}
+		return Record{}, fmt.Errorf("이미지가 아닌 응답: %s (Content-Type: %s)", sniffed, contentType)
+	}
+
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if errors.Is(err, image.ErrFormat) {
+		return Record{}, ErrUnsupported
+	}
+	if err != nil {
+		return Record{}, fmt.Errorf("이미지 헤더 파싱 실패: %w", err)
+	}
+	if cfg.Width <= 0 || cfg.Height <= 0 || cfg.Width*cfg.Height > maxPixels {
+		return Record{}, fmt.Errorf("허용하지 않는 이미지 크기: %dx%d", cfg.Width, cfg.Height)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return Record{}, fmt.Errorf("이미지 디코딩 실패: %w", err)
+	}
+	thumb := resize(img, p.Width)
+	flatten(thumb)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, thumb, &jpeg.Options{Quality: jpegQuality}); err != nil {
+		return Record{}, fmt.Errorf("이미지 인코딩 실패: %w", err)
+	}
+	key, err := p.save(buf.Bytes(), ".jpg")
+	if err != nil {
+		return Record{}, err
+	}
+	record := Record{
+		Key:       key,
+		Width:     thumb.Bounds().Dx(),
+		Height:    thumb.Bounds().Dy(),
+		Color:     averageColor(thumb),
+		FetchedAt: time.Now(),
+	}
+
+	var webp bytes.Buffer
+	if err := encodeWebP(&webp, thumb); err != nil {
+		return Record{}, fmt.Errorf("WebP 인코딩 실패: %w", err)
+	}
+	if webp.Len() < buf.Len() {
+		if record.WebPKey, err = p.save(webp.Bytes(), ".webp"); err != nil {
+			return Record{}, err
+		}
+	}
+	return record, nil
+}
+
+// save는 사본을 내용 해시 키로 저장하고 키를 반환합니다.
+func (p *Processor) save(data []byte, ext string) (string, error) {
+	sum := sha256.Sum256(data)
+	key := Prefix + hex.EncodeToString(sum[:])[:20] + ext
+	if err := p.store.Write(key, data); err != nil {
+		return "", err
+	}
+	return key, nil
+}
+
+// loadManifest는 저장된 매니페스트를 처음 한 번 읽습니다.
+func (p *Processor) loadManifest() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.loaded {
+		return nil
+	}
+
+	p.manifest = make(map[string]Record)
+	data, err := p.store.Read(ManifestKey)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return err
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &p.manifest); err != nil {
+			return fmt.Errorf("이미지 매니페스트 파싱 실패: %w", err)
+		}
+	}
+	p.loaded = true
+	return nil
+}
+
+// saveManifest는 새로 처리한 이미지가 있으면 매니페스트를 저장합니다.
+func (p *Processor) saveManifest() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.dirty {
+		return nil
+	}
+	data, err := json.MarshalIndent(p.manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("이미지 매니페스트 직렬화 실패: %w", err)
+	}
+	if err := p.store.Write(ManifestKey, data); err != nil {
+		return err
+	}
+	p.dirty = false
+	return nil
+}
@@ -0,0 +1,117 @@
+package images
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"math/rand/v2"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"hello-go/internal/models"
+	"hello-go/internal/storage"
+)
+
+func pngBytes(t *testing.T, width, height int) []byte {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := range width {
+		for y := range height {
+			img.Set(x, y, color.RGBA{R: 200, G: 40, B: 40, A: 255})
+		}
+	}
+	return encodePNG(t, img)
+}
+
+// noisePNG는 사진처럼 무손실 압축이 잘 되지 않는 PNG입니다.
+func noisePNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+	rng := rand.New(rand.NewPCG(1, 2))
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := range img.Pix {
+		img.Pix[i] = uint8(rng.IntN(256))
+		if i%4 == 3 {
+			img.Pix[i] = 0xff
+		}
+	}
+	return encodePNG(t, img)
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestApplySniffsContentType(t *testing.T) {
+	cover := pngBytes(t, 1280, 720)
+	photo := noisePNG(t, 320, 180)
+	// WebP 파일 헤더 (RIFF....WEBPVP8 )
+	webp := append([]byte("RIFF\x24\x00\x00\x00WEBPVP8 "), make([]byte, 32)...)
+	responses := map[string]struct {
+		contentType string
+		body        []byte
+	}{
+		"/octet.png":  {"application/octet-stream", cover},
+		"/photo.png":  {"image/png", photo},
+		"/html.png":   {"image/png", []byte("<!DOCTYPE html><html><body>Not Found</body></html>")},
+		"/cover.webp": {"image/webp", webp},
+		"/logo.svg":   {"image/svg+xml", []byte(`<svg xmlns="http://www.w3.org/2000/svg"></svg>`)},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, ok := responses[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", resp.contentType)
+		_, _ = w.Write(resp.body)
+	}))
+	defer server.Close()
+
+	var posts []models.BlogPost
+	for _, path := range []string{"/octet.png", "/html.png", "/cover.webp", "/logo.svg", "/photo.png"} {
+		posts = append(posts, models.BlogPost{URL: "https://blog.example.com" + path, Image: server.URL + path})
+	}
+
+	site := storage.NewFileStorage(t.TempDir())
+	rewritten, err := NewProcessor(site).Apply(posts)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// octet-stream으로 온 PNG는 내용으로 판단하여 사본 사용
+	if got := rewritten[0]; !strings.HasPrefix(got.Image, Prefix) || got.ImageWidth != DefaultWidth || got.ImageHeight != 360 {
+		t.Errorf("PNG 사본 = %q (%dx%d)", got.Image, got.ImageWidth, got.ImageHeight)
+	}
+	if _, err := site.Read(rewritten[0].Image); err != nil {
+		t.Errorf("사본이 저장되지 않음: %v", err)
+	}
+	// 단색 이미지는 무손실 WebP가 JPEG보다 작아 함께 저장
+	if webp, err := site.Read(rewritten[0].ImageWebP); err != nil || !bytes.HasPrefix(webp, []byte("RIFF")) || !strings.HasSuffix(rewritten[0].ImageWebP, ".webp") {
+		t.Errorf("WebP 사본 = %q: %v", rewritten[0].ImageWebP, err)
+	}
+	// 무손실 압축이 어려운 이미지는 JPEG만 사용
+	if got := rewritten[4]; !strings.HasSuffix(got.Image, ".jpg") || got.ImageWebP != "" {
+		t.Errorf("사진 사본 = %q, WebP = %q", got.Image, got.ImageWebP)
+	}
+	// 이미지 헤더로 온 HTML 오류 페이지는 실패 처리하여 자리 표시 이미지 사용
+	if got := rewritten[1].Image; got != "" {
+		t.Errorf("HTML 응답의 Image = %q, want 빈 값", got)
+	}
+	// 디코딩할 수 없는 WebP, SVG는 원본 주소 유지
+	for _, i := range []int{2, 3} {
+		if got := rewritten[i].Image; got != posts[i].Image {
+			t.Errorf("%s Image = %q, want 원본 %q", posts[i].URL, got, posts[i].Image)
+		}
+	}
+	if _, err := site.Read(ManifestKey); err != nil {
+		t.Errorf("매니페스트 없음: %v", err)
+	}
+}
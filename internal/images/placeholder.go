@@ -0,0 +1,178 @@
+package images
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"html"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"hello-go/internal/models"
+)
+
+// 자리 표시 이미지의 크기와 제목 배치입니다. 카드 이미지 영역과 같은 3:2 비율입니다.
+const (
+	coverWidth     = 600
+	coverHeight    = 400
+	coverPadding   = 40
+	titleFontSize  = 40
+	titleLineGap   = 1.3
+	titleMaxLines  = 3
+	coverFontStack = `Pretendard, 'Apple SD Gothic Neo', 'Noto Sans KR', 'Malgun Gothic', sans-serif`
+)
+
+// Placeholder는 이미지가 없는 포스트의 커버를 SVG로 생성합니다.
+// 배경색은 카테고리로, 장식 배치는 포스트 URL로 정해지므로 같은 포스트는 항상 같은 커버를 갖고
+// 같은 카테고리의 포스트는 같은 색 계열로 보입니다.
+func Placeholder(post models.BlogPost) string {
+	hue := categoryHue(post.Category)
+	seed := sha256.Sum256([]byte(post.URL))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`,
+		coverWidth, coverHeight, coverWidth, coverHeight)
+	fmt.Fprintf(&b, `<defs><linearGradient id="g" x1="0" y1="0" x2="1" y2="1">`+
+		`<stop offset="0" stop-color="hsl(%d,62%%,52%%)"/><stop offset="1" stop-color="hsl(%d,58%%,34%%)"/>`+
+		`</linearGradient></defs>`, hue, (hue+28)%360)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="url(#g)"/>`, coverWidth, coverHeight)
+
+	// 포스트마다 다른 위치와 크기의 반투명 원 세 개
+	for i := range 3 {
+		v := binary.BigEndian.Uint32(seed[i*4:])
+		cx := int(v % coverWidth)
+		cy := int(v / coverWidth % coverHeight)
+		r := 60 + int(v/coverWidth/coverHeight%140)
+		fmt.Fprintf(&b, `<circle cx="%d" cy="%d" r="%d" fill="#fff" fill-opacity="0.08"/>`, cx, cy, r)
+	}
+
+	fmt.Fprintf(&b, `<g font-family="%s" fill="#fff">`, html.EscapeString(coverFontStack))
+	if post.Source != "" {
+		fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="24" font-weight="600" fill-opacity="0.85">%s</text>`,
+			coverPadding, coverPadding+24, html.EscapeString(post.Source))
+	}
+
+	lines := wrapTitle(post.Title, coverWidth-2*coverPadding, titleFontSize, titleMaxLines)
+	lineHeight := float64(titleFontSize) * titleLineGap
+	// 제목 블록을 세로 가운데에 배치 (첫 줄 기준선 위치)
+	y := (float64(coverHeight)-lineHeight*float64(len(lines)))/2 + float64(titleFontSize)
+	fmt.Fprintf(&b, `<text x="%d" y="%.0f" font-size="%d" font-weight="700">`, coverPadding, y, titleFontSize)
+	for i, line := range lines {
+		dy := 0.0
+		if i > 0 {
+			dy = lineHeight
+		}
+		fmt.Fprintf(&b, `<tspan x="%d" dy="%.0f">%s</tspan>`, coverPadding, dy, html.EscapeString(line))
+	}
+	b.WriteString(`</text>`)
+
+	if post.Category != "" {
+		fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="22" fill-opacity="0.75"># %s</text>`,
+			coverPadding, coverHeight-coverPadding, html.EscapeString(post.Category))
+	}
+	b.WriteString(`</g></svg>`)
+	return b.String()
+}
+
+// PlaceholderURI는 Placeholder를 CSS와 HTML 속성에 그대로 넣을 수 있는 data URI로 반환합니다.
+func PlaceholderURI(post models.BlogPost) string {
+	return "data:image/svg+xml;base64," + base64.StdEncoding.EncodeToString([]byte(Placeholder(post)))
+}
+
+// PlaceholderColor는 Placeholder 커버의 대표 색입니다. 커버를 그리기 전 자리 표시 배경색으로 사용합니다.
+func PlaceholderColor(post models.BlogPost) string {
+	return fmt.Sprintf("hsl(%d,60%%,43%%)", categoryHue(post.Category))
+}
+
+// categoryHue는 카테고리 이름으로 색상(hue)을 정합니다. 카테고리가 없으면 사이트 기본색 계열을 사용합니다.
+func categoryHue(category string) int {
+	if category == "" {
+		return 235
+	}
+	sum := sha256.Sum256([]byte(category))
+	return int(binary.BigEndian.Uint16(sum[:]) % 360)
+}
+
+// wrapTitle은 제목을 maxWidth 안에 들어가도록 최대 maxLines 줄로 나눕니다. 넘치는 부분은 말줄임표로 줄입니다.
+// SVG는 자동 줄바꿈이 없으므로 글자 폭을 추정하여 나누며, 공백에서 먼저 나누고
+// 한 단어가 한 줄보다 길면(띄어쓰기 없는 한글, 긴 영문 식별자 등) 글자 단위로 나눕니다.
+func wrapTitle(title string, maxWidth, fontSize, maxLines int) []string {
+	limit := float64(maxWidth) / float64(fontSize)
+	words := strings.Fields(title)
+	if len(words) == 0 {
+		return nil
+	}
+
+	var lines []string
+	var line string
+	for _, word := range words {
+		candidate := word
+		if line != "" {
+			candidate = line + " " + word
+		}
+		if textWidth(candidate) <= limit {
+			line = candidate
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+		// 한 줄보다 긴 단어는 글자 단위로 나눔
+		for textWidth(word) > limit {
+			head := fitPrefix(word, limit)
+			lines = append(lines, head)
+			word = word[len(head):]
+		}
+		line = word
+	}
+	if line != "" {
+		lines = append(lines, line)
+	}
+
+	if len(lines) > maxLines {
+		last := fitPrefix(lines[maxLines-1], limit-textWidth("…"))
+		lines = append(lines[:maxLines-1], strings.TrimRightFunc(last, unicode.IsSpace)+"…")
+	}
+	return lines
+}
+
+// fitPrefix는 s의 앞부분 중 폭이 limit 이하인 가장 긴 부분을 반환합니다. 최소 한 글자는 포함합니다.
+func fitPrefix(s string, limit float64) string {
+	width := 0.0
+	for i, r := range s {
+		width += runeWidth(r)
+		if width > limit && i > 0 {
+			return s[:i]
+		}
+	}
+	return s
+}
+
+// textWidth는 글꼴 크기 대비 문자열의 대략적인 폭입니다.
+func textWidth(s string) float64 {
+	width := 0.0
+	for _, r := range s {
+		width += runeWidth(r)
+	}
+	return width
+}
+
+// runeWidth는 글꼴 크기 대비 글자 하나의 대략적인 폭입니다. 한글·한자·가나와 전각 문자는 정사각형에 가깝습니다.
+func runeWidth(r rune) float64 {
+	switch {
+	case unicode.Is(unicode.Hangul, r), unicode.Is(unicode.Han, r),
+		unicode.Is(unicode.Hiragana, r), unicode.Is(unicode.Katakana, r),
+		r >= 0xFF00 && r <= 0xFFEF, utf8.RuneLen(r) == 4:
+		return 1.0
+	case r == ' ':
+		return 0.3
+	case unicode.IsUpper(r), r == 'm', r == 'w':
+		return 0.7
+	case r == 'i', r == 'l', r == 'j', r == '.', r == ',', r == '\'', r == '|', r == '!':
+		return 0.3
+	default:
+		return 0.56
+	}
+}
@@ -0,0 +1,78 @@
+package images
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+)
+
+// resize는 src를 가로 width 이하로 비율을 유지하여 축소합니다. 이미 작으면 그대로 RGBA로 변환합니다.
+// 대상 픽셀 하나에 해당하는 원본 영역의 평균을 사용하는 면적 평균 방식이라 축소 시 계단 현상이 적습니다.
+func resize(src image.Image, width int) *image.RGBA {
+	b := src.Bounds()
+	sw, sh := b.Dx(), b.Dy()
+	dw, dh := sw, sh
+	if sw > width {
+		dw = width
+		dh = max(1, sh*width/sw)
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		y0 := b.Min.Y + y*sh/dh
+		y1 := max(y0+1, b.Min.Y+(y+1)*sh/dh)
+		for x := 0; x < dw; x++ {
+			x0 := b.Min.X + x*sw/dw
+			x1 := max(x0+1, b.Min.X+(x+1)*sw/dw)
+
+			var r, g, bl, a, n uint64
+			for sy := y0; sy < y1; sy++ {
+				for sx := x0; sx < x1; sx++ {
+					cr, cg, cb, ca := src.At(sx, sy).RGBA()
+					r += uint64(cr)
+					g += uint64(cg)
+					bl += uint64(cb)
+					a += uint64(ca)
+					n++
+				}
+			}
+			dst.SetRGBA(x, y, color.RGBA{
+				R: uint8(r / n >> 8),
+				G: uint8(g / n >> 8),
+				B: uint8(bl / n >> 8),
+				A: uint8(a / n >> 8),
+			})
+		}
+	}
+	return dst
+}
+
+// flatten은 투명한 픽셀을 흰 배경에 합성합니다. JPEG은 알파 채널이 없으므로 인코딩 전에 호출합니다.
+func flatten(img *image.RGBA) {
+	for i := 0; i < len(img.Pix); i += 4 {
+		a := uint32(img.Pix[i+3])
+		if a == 0xff {
+			continue
+		}
+		// RGBA는 알파가 곱해진 값이므로 흰 배경이 비치는 만큼(255-a)만 더함
+		for c := 0; c < 3; c++ {
+			img.Pix[i+c] += uint8(0xff - a)
+		}
+		img.Pix[i+3] = 0xff
+	}
+}
+
+// averageColor는 불투명한 이미지의 평균 색을 #rrggbb로 반환합니다. 이미지를 받기 전 자리 표시 배경색으로 사용합니다.
+func averageColor(img *image.RGBA) string {
+	var r, g, b, n uint64
+	for i := 0; i < len(img.Pix); i += 4 {
+		r += uint64(img.Pix[i])
+		g += uint64(img.Pix[i+1])
+		b += uint64(img.Pix[i+2])
+		n++
+	}
+	if n == 0 {
+		return ""
+	}
+	return fmt.Sprintf("#%02x%02x%02x", r/n, g/n, b/n)
+}
@@ -0,0 +1,581 @@
+package images
+
+import (
+	"encoding/binary"
+	"fmt"
+	"image"
+	"io"
+	"sort"
+)
+
+// WebP 무손실(VP8L) 인코더입니다. golang.org/x/image에는 디코더만 있어 표준 라이브러리만으로 직접 구현합니다.
+// 녹색 빼기와 예측 변환을 적용한 뒤, 왼쪽·위쪽 픽셀 반복은 역참조로, 최근 색은 색 캐시로 줄이고 나머지를 허프만 부호로 씁니다.
+// 형식은 https://developers.google.com/speed/webp/docs/webp_lossless_bitstream_specification 을 따릅니다.
+
+const (
+	// vp8lMaxSize는 VP8L이 표현할 수 있는 최대 가로·세로 크기입니다.
+	vp8lMaxSize = 1 << 14
+	// predictorBits는 예측 모드를 고르는 타일 크기(2^bits 픽셀)입니다.
+	predictorBits = 4
+	// colorCacheBits는 색 캐시 크기(2^bits)입니다.
+	colorCacheBits = 10
+	// 역참조 길이 범위입니다.
+	minCopyLength = 3
+	maxCopyLength = 4096
+
+	numLengthCodes   = 24
+	numDistanceCodes = 40
+	maxCodeLength    = 15
+	maxLengthCodeLen = 7
+)
+
+// codeLengthOrder는 코드 길이 부호의 길이를 쓰는 순서입니다.
+var codeLengthOrder = [19]int{17, 18, 0, 1, 2, 3, 4, 5, 16, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}
+
+// predictorModes는 타일마다 시험하는 예측 모드입니다. 오른쪽 위 픽셀을 쓰는 모드는 가장자리 규칙이 복잡해 제외합니다.
+var predictorModes = []uint32{1, 2, 4, 6, 7, 8, 12}
+
+// encodeWebP는 불투명한 img를 무손실 WebP로 씁니다.
+func encodeWebP(w io.Writer, img *image.RGBA) error {
+	b := img.Bounds()
+	width, height := b.Dx(), b.Dy()
+	if width <= 0 || height <= 0 || width > vp8lMaxSize || height > vp8lMaxSize {
+		return fmt.Errorf("WebP로 인코딩할 수 없는 크기: %dx%d", width, height)
+	}
+
+	argb := make([]uint32, 0, width*height)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		row := img.Pix[img.PixOffset(b.Min.X, y):]
+		for x := 0; x < width; x++ {
+			p := row[x*4 : x*4+4]
+			argb = append(argb, uint32(p[3])<<24|uint32(p[0])<<16|uint32(p[1])<<8|uint32(p[2]))
+		}
+	}
+
+	bw := &bitWriter{}
+	bw.write(0x2f, 8) // VP8L 서명
+	bw.write(uint32(width-1), 14)
+	bw.write(uint32(height-1), 14)
+	bw.write(0, 1) // 알파 미사용
+	bw.write(0, 3) // 버전
+
+	// 변환은 쓰는 순서대로 적용하며, 디코더는 반대 순서로 되돌림
+	subtractGreen(argb)
+	bw.write(1, 1)
+	bw.write(2, 2)
+
+	modes, tilesX, tilesY := choosePredictors(argb, width, height)
+	bw.write(1, 1)
+	bw.write(0, 2)
+	bw.write(predictorBits-2, 3)
+	writeImage(bw, modes, tilesX, tilesY, 0, false)
+	residuals := predict(argb, modes, width, height, tilesX)
+
+	bw.write(0, 1) // 변환 끝
+	writeImage(bw, residuals, width, height, colorCacheBits, true)
+
+	data := bw.bytes()
+	padded := len(data) + len(data)&1
+	header := make([]byte, 20)
+	copy(header[0:], "RIFF")
+	binary.LittleEndian.PutUint32(header[4:], uint32(12+padded))
+	copy(header[8:], "WEBPVP8L")
+	binary.LittleEndian.PutUint32(header[16:], uint32(len(data)))
+	if len(data)&1 == 1 {
+		data = append(data, 0)
+	}
+	if _, err := w.Write(header); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+// bitWriter는 비트를 바이트의 낮은 자리부터 채워 씁니다.
+type bitWriter struct {
+	buf  []byte
+	acc  uint64
+	nacc uint
+}
+
+func (w *bitWriter) write(v uint32, n uint) {
+	w.acc |= uint64(v) << w.nacc
+	w.nacc += n
+	for w.nacc >= 8 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc >>= 8
+		w.nacc -= 8
+	}
+}
+
+func (w *bitWriter) bytes() []byte {
+	if w.nacc > 0 {
+		w.buf = append(w.buf, byte(w.acc))
+		w.acc, w.nacc = 0, 0
+	}
+	return w.buf
+}
+
+// subtractGreen은 빨강과 파랑에서 녹색을 뺍니다.
+func subtractGreen(argb []uint32) {
+	for i, p := range argb {
+		g := (p >> 8) & 0xff
+		r := ((p >> 16) - g) & 0xff
+		bl := (p - g) & 0xff
+		argb[i] = p&0xff00ff00 | r<<16 | bl
+	}
+}
+
+// choosePredictors는 타일마다 잔차의 절댓값 합이 가장 작은 예측 모드를 고르고, 모드를 녹색 채널에 담은 부분 이미지를 반환합니다.
+func choosePredictors(argb []uint32, width, height int) ([]uint32, int, int) {
+	size := 1 << predictorBits
+	tilesX, tilesY := (width+size-1)/size, (height+size-1)/size
+	modes := make([]uint32, tilesX*tilesY)
+	for ty := range tilesY {
+		for tx := range tilesX {
+			best, bestCost := predictorModes[0], -1
+			for _, mode := range predictorModes {
+				cost := 0
+				for y := ty * size; y < min((ty+1)*size, height); y++ {
+					for x := tx * size; x < min((tx+1)*size, width); x++ {
+						cost += residualCost(sub(argb[y*width+x], predictPixel(argb, x, y, width, mode)))
+					}
+				}
+				if bestCost < 0 || cost < bestCost {
+					best, bestCost = mode, cost
+				}
+			}
+			modes[ty*tilesX+tx] = 0xff000000 | best<<8
+		}
+	}
+	return modes, tilesX, tilesY
+}
+
+// predict는 고른 모드로 잔차 이미지를 만듭니다.
+func predict(argb, modes []uint32, width, height, tilesX int) []uint32 {
+	residuals := make([]uint32, len(argb))
+	for y := range height {
+		for x := range width {
+			mode := (modes[(y>>predictorBits)*tilesX+x>>predictorBits] >> 8) & 0xf
+			residuals[y*width+x] = sub(argb[y*width+x], predictPixel(argb, x, y, width, mode))
+		}
+	}
+	return residuals
+}
+
+// predictPixel은 (x, y) 픽셀의 예측값입니다. 첫 행은 왼쪽, 첫 열은 위쪽 픽셀을 사용합니다.
+func predictPixel(argb []uint32, x, y, width int, mode uint32) uint32 {
+	switch {
+	case x == 0 && y == 0:
+		return 0xff000000
+	case y == 0:
+		return argb[x-1]
+	case x == 0:
+		return argb[(y-1)*width]
+	}
+	i := y*width + x
+	l, t, tl := argb[i-1], argb[i-width], argb[i-width-1]
+	switch mode {
+	case 1:
+		return l
+	case 2:
+		return t
+	case 4:
+		return tl
+	case 6:
+		return average2(l, tl)
+	case 7:
+		return average2(l, t)
+	case 8:
+		return average2(tl, t)
+	case 12:
+		return clampAddSubtract(l, t, tl)
+	}
+	return 0xff000000
+}
+
+// channels는 a, b, c의 각 채널에 f를 적용한 픽셀을 반환합니다.
+func channels(a, b, c uint32, f func(a, b, c int) int) uint32 {
+	var p uint32
+	for shift := 0; shift < 32; shift += 8 {
+		v := f(int(a>>shift&0xff), int(b>>shift&0xff), int(c>>shift&0xff))
+		p |= uint32(v&0xff) << shift
+	}
+	return p
+}
+
+func average2(a, b uint32) uint32 {
+	return channels(a, b, 0, func(a, b, _ int) int { return (a + b) / 2 })
+}
+
+func clampAddSubtract(a, b, c uint32) uint32 {
+	return channels(a, b, c, func(a, b, c int) int { return min(max(a+b-c, 0), 255) })
+}
+
+func sub(a, b uint32) uint32 {
+	return channels(a, b, 0, func(a, b, _ int) int { return a - b })
+}
+
+// residualCost는 잔차 채널을 부호 있는 값으로 보았을 때의 절댓값 합입니다.
+func residualCost(p uint32) int {
+	cost := 0
+	for shift := 0; shift < 32; shift += 8 {
+		v := int(int8(p >> shift))
+		cost += max(v, -v)
+	}
+	return cost
+}
+
+// token은 픽셀 하나(리터럴, 색 캐시) 또는 역참조 하나입니다.
+type token struct {
+	kind   uint8
+	argb   uint32 // 리터럴
+	index  int    // 색 캐시 위치
+	length int    // 역참조 길이
+	dist   int    // 역참조 거리 코드
+}
+
+const (
+	tokenLiteral = iota
+	tokenCache
+	tokenCopy
+)
+
+// tokenize는 픽셀을 토큰으로 나눕니다. 역참조는 왼쪽 픽셀 반복과 위 행 반복만 찾습니다.
+func tokenize(argb []uint32, width, cacheBits int) []token {
+	var cache []uint32
+	if cacheBits > 0 {
+		cache = make([]uint32, 1<<cacheBits)
+	}
+	insert := func(p uint32) int {
+		if cache == nil {
+			return -1
+		}
+		key := int((0x1e35a7bd * p) >> (32 - cacheBits))
+		cache[key] = p
+		return key
+	}
+
+	var tokens []token
+	for i := 0; i < len(argb); {
+		// 거리 코드 2는 왼쪽 픽셀(거리 1), 1은 위 픽셀(거리 width)
+		length, dist := 0, 0
+		for _, c := range []struct{ code, distance int }{{2, 1}, {1, width}} {
+			if i < c.distance {
+				continue
+			}
+			n := 0
+			for i+n < len(argb) && n < maxCopyLength && argb[i+n] == argb[i+n-c.distance] {
+				n++
+			}
+			if n > length {
+				length, dist = n, c.code
+			}
+		}
+		if length >= minCopyLength {
+			tokens = append(tokens, token{kind: tokenCopy, length: length, dist: dist})
+			for _, p := range argb[i : i+length] {
+				insert(p)
+			}
+			i += length
+			continue
+		}
+
+		p := argb[i]
+		if cache != nil {
+			key := int((0x1e35a7bd * p) >> (32 - cacheBits))
+			if cache[key] == p {
+				tokens = append(tokens, token{kind: tokenCache, index: key})
+				i++
+				continue
+			}
+		}
+		insert(p)
+		tokens = append(tokens, token{kind: tokenLiteral, argb: p})
+		i++
+	}
+	return tokens
+}
+
+// prefixEncode는 길이·거리 값을 접두 부호와 추가 비트로 나눕니다.
+func prefixEncode(v int) (code int, extraBits uint, extra uint32) {
+	d := v - 1
+	if d < 4 {
+		return d, 0, 0
+	}
+	h := 0
+	for d>>(h+1) != 0 {
+		h++
+	}
+	second := (d >> (h - 1)) & 1
+	extraBits = uint(h - 1)
+	return 2*h + second, extraBits, uint32(d & (1<<extraBits - 1))
+}
+
+// prefixCode는 허프만 부호 하나의 길이와 (비트 순서를 뒤집은) 부호입니다.
+type prefixCode struct {
+	lengths []uint8
+	codes   []uint32
+}
+
+func (c *prefixCode) write(w *bitWriter, symbol int) {
+	w.write(c.codes[symbol], uint(c.lengths[symbol]))
+}
+
+// writeImage는 픽셀을 허프만 부호로 씁니다. main이면 메타 부호를 쓰지 않는다는 비트를 추가합니다.
+func writeImage(w *bitWriter, argb []uint32, width, height, cacheBits int, main bool) {
+	tokens := tokenize(argb, width, cacheBits)
+
+	cacheSize := 0
+	if cacheBits > 0 {
+		cacheSize = 1 << cacheBits
+	}
+	counts := [5][]int{
+		make([]int, 256+numLengthCodes+cacheSize),
+		make([]int, 256),
+		make([]int, 256),
+		make([]int, 256),
+		make([]int, numDistanceCodes),
+	}
+	for _, t := range tokens {
+		switch t.kind {
+		case tokenLiteral:
+			counts[0][t.argb>>8&0xff]++
+			counts[1][t.argb>>16&0xff]++
+			counts[2][t.argb&0xff]++
+			counts[3][t.argb>>24]++
+		case tokenCache:
+			counts[0][256+numLengthCodes+t.index]++
+		case tokenCopy:
+			code, _, _ := prefixEncode(t.length)
+			counts[0][256+code]++
+			code, _, _ = prefixEncode(t.dist)
+			counts[4][code]++
+		}
+	}
+
+	if cacheBits > 0 {
+		w.write(1, 1)
+		w.write(uint32(cacheBits), 4)
+	} else {
+		w.write(0, 1)
+	}
+	if main {
+		w.write(0, 1)
+	}
+
+	var codes [5]*prefixCode
+	for i := range codes {
+		codes[i] = writePrefixCode(w, counts[i])
+	}
+
+	for _, t := range tokens {
+		switch t.kind {
+		case tokenLiteral:
+			codes[0].write(w, int(t.argb>>8&0xff))
+			codes[1].write(w, int(t.argb>>16&0xff))
+			codes[2].write(w, int(t.argb&0xff))
+			codes[3].write(w, int(t.argb>>24))
+		case tokenCache:
+			codes[0].write(w, 256+numLengthCodes+t.index)
+		case tokenCopy:
+			code, bits, extra := prefixEncode(t.length)
+			codes[0].write(w, 256+code)
+			w.write(extra, bits)
+			code, bits, extra = prefixEncode(t.dist)
+			codes[4].write(w, code)
+			w.write(extra, bits)
+		}
+	}
+}
+
+// writePrefixCode는 빈도로 허프만 부호를 만들어 쓰고 반환합니다.
+// 8비트로 표현되는 기호가 두 개 이하면 단순 부호를, 아니면 코드 길이를 다시 허프만 부호로 압축하여 씁니다.
+func writePrefixCode(w *bitWriter, counts []int) *prefixCode {
+	var used []int
+	for symbol, n := range counts {
+		if n > 0 {
+			used = append(used, symbol)
+		}
+	}
+	if len(used) == 0 {
+		used = []int{0}
+	}
+
+	if len(used) <= 2 && used[len(used)-1] < 256 {
+		w.write(1, 1)
+		w.write(uint32(len(used)-1), 1)
+		if used[0] < 2 {
+			w.write(0, 1)
+			w.write(uint32(used[0]), 1)
+		} else {
+			w.write(1, 1)
+			w.write(uint32(used[0]), 8)
+		}
+		if len(used) == 2 {
+			w.write(uint32(used[1]), 8)
+		}
+		code := newPrefixCode(codeLengths(counts, maxCodeLength))
+		if len(used) == 1 {
+			// 기호가 하나면 비트를 쓰지 않음
+			code.lengths[used[0]], code.codes[used[0]] = 0, 0
+		}
+		return code
+	}
+
+	lengths := codeLengths(counts, maxCodeLength)
+	w.write(0, 1)
+
+	// 코드 길이를 0 반복(17, 18)과 직전 길이 반복(16)으로 줄임
+	type lengthToken struct {
+		symbol    int
+		extra     uint32
+		extraBits uint
+	}
+	var tokens []lengthToken
+	for i := 0; i < len(lengths); {
+		v := lengths[i]
+		run := 1
+		for i+run < len(lengths) && lengths[i+run] == v {
+			run++
+		}
+		i += run
+		if v == 0 {
+			for run >= 11 {
+				n := min(run, 138)
+				tokens = append(tokens, lengthToken{18, uint32(n - 11), 7})
+				run -= n
+			}
+			if run >= 3 {
+				tokens = append(tokens, lengthToken{17, uint32(run - 3), 3})
+				run = 0
+			}
+		} else {
+			tokens = append(tokens, lengthToken{symbol: int(v)})
+			run--
+			for run >= 3 {
+				n := min(run, 6)
+				tokens = append(tokens, lengthToken{16, uint32(n - 3), 2})
+				run -= n
+			}
+		}
+		for ; run > 0; run-- {
+			tokens = append(tokens, lengthToken{symbol: int(v)})
+		}
+	}
+
+	lengthCounts := make([]int, len(codeLengthOrder))
+	for _, t := range tokens {
+		lengthCounts[t.symbol]++
+	}
+	lengthCode := newPrefixCode(codeLengths(lengthCounts, maxLengthCodeLen))
+	n := len(codeLengthOrder)
+	for n > 4 && lengthCode.lengths[codeLengthOrder[n-1]] == 0 {
+		n--
+	}
+	w.write(uint32(n-4), 4)
+	for _, symbol := range codeLengthOrder[:n] {
+		w.write(uint32(lengthCode.lengths[symbol]), 3)
+	}
+	w.write(0, 1) // 알파벳 전체의 길이를 씀
+	for _, t := range tokens {
+		lengthCode.write(w, t.symbol)
+		w.write(t.extra, t.extraBits)
+	}
+	return newPrefixCode(lengths)
+}
+
+// codeLengths는 최대 길이가 limit 이하인 허프만 부호 길이를 구합니다.
+// 길이가 넘치면 작은 빈도를 키워 다시 만들며, 기호가 하나뿐이면 다른 기호를 더해 완전한 부호를 만듭니다.
+func codeLengths(counts []int, limit int) []uint8 {
+	lengths := make([]uint8, len(counts))
+	var symbols []int
+	for symbol, n := range counts {
+		if n > 0 {
+			symbols = append(symbols, symbol)
+		}
+	}
+	switch len(symbols) {
+	case 0:
+		return lengths
+	case 1:
+		lengths[symbols[0]] = 1
+		lengths[(symbols[0]+1)%len(counts)] = 1
+		return lengths
+	}
+
+	type node struct {
+		weight, parent int
+	}
+	for floor := 1; ; floor *= 2 {
+		leaves := make([]int, len(symbols))
+		copy(leaves, symbols)
+		weight := func(symbol int) int { return max(counts[symbol], floor) }
+		sort.SliceStable(leaves, func(i, j int) bool { return weight(leaves[i]) < weight(leaves[j]) })
+
+		// 잎과 내부 노드를 각각 가중치 순 큐로 두고 가장 가벼운 두 노드를 합침
+		nodes := make([]node, 0, 2*len(leaves))
+		for _, symbol := range leaves {
+			nodes = append(nodes, node{weight: weight(symbol), parent: -1})
+		}
+		nextLeaf, nextInner := 0, len(leaves)
+		lightest := func() int {
+			if nextLeaf < len(leaves) && (nextInner >= len(nodes) || nodes[nextLeaf].weight <= nodes[nextInner].weight) {
+				nextLeaf++
+				return nextLeaf - 1
+			}
+			nextInner++
+			return nextInner - 1
+		}
+		for range len(leaves) - 1 {
+			a, b := lightest(), lightest()
+			nodes = append(nodes, node{weight: nodes[a].weight + nodes[b].weight, parent: -1})
+			nodes[a].parent, nodes[b].parent = len(nodes)-1, len(nodes)-1
+		}
+
+		depths := make([]int, len(nodes))
+		deepest := 0
+		for i := len(nodes) - 2; i >= 0; i-- {
+			depths[i] = depths[nodes[i].parent] + 1
+			deepest = max(deepest, depths[i])
+		}
+		if deepest > limit {
+			continue
+		}
+		for i, symbol := range leaves {
+			lengths[symbol] = uint8(depths[i])
+		}
+		return lengths
+	}
+}
+
+// newPrefixCode는 길이로 정규 허프만 부호를 만듭니다. 비트를 낮은 자리부터 쓰므로 부호의 비트 순서를 뒤집어 둡니다.
+func newPrefixCode(lengths []uint8) *prefixCode {
+	var count [maxCodeLength + 1]uint32
+	for _, n := range lengths {
+		count[n]++
+	}
+	count[0] = 0
+	var next [maxCodeLength + 1]uint32
+	code := uint32(0)
+	for n := 1; n <= maxCodeLength; n++ {
+		code = (code + count[n-1]) << 1
+		next[n] = code
+	}
+
+	codes := make([]uint32, len(lengths))
+	for symbol, n := range lengths {
+		if n == 0 {
+			continue
+		}
+		c := next[n]
+		next[n]++
+		var reversed uint32
+		for range n {
+			reversed = reversed<<1 | c&1
+			c >>= 1
+		}
+		codes[symbol] = reversed
+	}
+	return &prefixCode{lengths: lengths, codes: codes}
+}
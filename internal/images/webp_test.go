@@ -0,0 +1,114 @@
+package images
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"math/rand/v2"
+	"testing"
+)
+
+func TestEncodeWebPHeader(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 37, 21))
+	for y := range 21 {
+		for x := range 37 {
+			img.SetRGBA(x, y, color.RGBA{R: uint8(x * 7), G: uint8(y * 11), B: 90, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := encodeWebP(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+
+	if string(data[0:4]) != "RIFF" || string(data[8:16]) != "WEBPVP8L" {
+		t.Fatalf("헤더 = %q", data[:16])
+	}
+	if size := binary.LittleEndian.Uint32(data[4:]); int(size) != len(data)-8 {
+		t.Errorf("RIFF 크기 = %d, 파일 %d바이트", size, len(data))
+	}
+	chunk := binary.LittleEndian.Uint32(data[16:])
+	if int(chunk+chunk&1) != len(data)-20 {
+		t.Errorf("VP8L 청크 크기 = %d, 남은 %d바이트", chunk, len(data)-20)
+	}
+	if data[20] != 0x2f {
+		t.Errorf("서명 = %#x", data[20])
+	}
+	bits := binary.LittleEndian.Uint32(data[21:])
+	if width, height := bits&0x3fff+1, bits>>14&0x3fff+1; width != 37 || height != 21 {
+		t.Errorf("크기 = %dx%d, want 37x21", width, height)
+	}
+}
+
+func TestEncodeWebPRejectsOversized(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 1, vp8lMaxSize+1))
+	if err := encodeWebP(&bytes.Buffer{}, img); err == nil {
+		t.Error("오류 없음")
+	}
+}
+
+func TestCodeLengthsAreCompleteAndLimited(t *testing.T) {
+	rng := rand.New(rand.NewPCG(3, 4))
+	random := make([]int, 280)
+	for i := range random {
+		random[i] = rng.IntN(1000)
+	}
+	// 피보나치 빈도는 제한이 없으면 기호 수만큼 깊어짐
+	fibonacci := make([]int, 30)
+	fibonacci[0], fibonacci[1] = 1, 1
+	for i := 2; i < len(fibonacci); i++ {
+		fibonacci[i] = fibonacci[i-1] + fibonacci[i-2]
+	}
+
+	tests := []struct {
+		name   string
+		counts []int
+		limit  int
+	}{
+		{"random", random, maxCodeLength},
+		{"fibonacci", fibonacci, maxCodeLength},
+		{"code length code", fibonacci[:19], maxLengthCodeLen},
+		{"one symbol", []int{0, 0, 5, 0}, maxCodeLength},
+		{"two symbols", []int{0, 9, 0, 1}, maxCodeLength},
+	}
+	for _, tt := range tests {
+		lengths := codeLengths(tt.counts, tt.limit)
+		// 부호가 완전하면 2^-길이의 합이 정확히 1
+		var kraft, used uint64
+		for symbol, n := range lengths {
+			if n == 0 {
+				if tt.counts[symbol] > 0 {
+					t.Errorf("%s: 기호 %d의 길이가 0", tt.name, symbol)
+				}
+				continue
+			}
+			if int(n) > tt.limit {
+				t.Errorf("%s: 길이 %d > %d", tt.name, n, tt.limit)
+			}
+			kraft += 1 << (maxCodeLength - n)
+			used++
+		}
+		if kraft != 1<<maxCodeLength || used < 2 {
+			t.Errorf("%s: 완전하지 않은 부호 (Kraft %d/%d, 기호 %d개)", tt.name, kraft, 1<<maxCodeLength, used)
+		}
+	}
+}
+
+func TestPrefixEncode(t *testing.T) {
+	for v := 1; v <= maxCopyLength; v++ {
+		code, bits, extra := prefixEncode(v)
+		// 명세의 디코딩 방식으로 되돌림
+		got := code + 1
+		if code >= 4 {
+			extraBits := (code - 2) >> 1
+			if uint(extraBits) != bits {
+				t.Fatalf("%d: 추가 비트 %d, want %d", v, bits, extraBits)
+			}
+			got = (2+code&1)<<extraBits + int(extra) + 1
+		}
+		if got != v || code >= numLengthCodes {
+			t.Fatalf("prefixEncode(%d) = %d, %d, %d → %d", v, code, bits, extra, got)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package job
+
+import (
+	"fmt"
+	"time"
+
+	"hello-go/internal"
+	"hello-go/internal/crawlers"
+	"hello-go/internal/health"
+	"hello-go/internal/models"
+)
+
+// 실행 모드 목록입니다.
+const (
+	// ModeCrawl은 호출 하나에서 모든 소스를 크롤링하고 결과를 게시합니다. (기본값)
+	ModeCrawl = "crawl"
+	// ModeWorker는 Source 하나만 크롤링하여 partial로 저장합니다.
+	ModeWorker = "worker"
+	// ModeMerge는 저장된 partial을 모아 중복 제거, 필터링 후 결과를 게시합니다.
+	ModeMerge = "merge"
+	// ModeCoordinator는 소스마다 워커를 호출한 뒤 병합합니다.
+	ModeCoordinator = "coordinator"
+	// ModeHealthcheck는 크롤러별 필수 필드 완성도를 저장된 기대치와 비교합니다.
+	ModeHealthcheck = "healthcheck"
+)
+
+// Event는 Lambda 호출 payload입니다. 모든 필드는 생략할 수 있습니다.
+type Event struct {
+	// Mode는 실행 모드입니다. (crawl, worker, merge, coordinator, healthcheck)
+	Mode string `json:"mode"`
+	// Source는 worker 모드에서 크롤링할 소스 ID입니다.
+	Source string `json:"source"`
+	// Sources는 크롤링(또는 병합)할 소스 ID 목록입니다. (toss, daangn, danmin, naver, kakao) 비어 있으면 전체 소스가 대상입니다.
+	Sources []string `json:"sources"`
+	// Since는 이 날짜(YYYY-MM-DD) 이후 발행된 포스트만 포함합니다. 비어 있으면 FILTER_DATE 환경변수를 사용합니다.
+	Since string `json:"since"`
+	// Until은 이 날짜(YYYY-MM-DD)까지 발행된 포스트만 포함합니다.
+	Until string `json:"until"`
+	// DryRun이면 크롤링과 비교만 하고 S3에 아무것도 쓰지 않습니다.
+	DryRun bool `json:"dry_run"`
+	// Outputs는 업로드할 출력 형식 목록입니다. (html, json) 비어 있으면 html만 업로드합니다.
+	Outputs []string `json:"outputs"`
+	// FullRecrawl이면 직전 스냅샷을 무시하고 Since부터 전부 다시 크롤링합니다.
+	FullRecrawl bool `json:"full_recrawl"`
+	// Enrich이면 포스트마다 상세 페이지를 가져와 JSON-LD, OpenGraph 메타데이터로 작성자, 날짜, 태그 등을 보강합니다.
+	Enrich bool `json:"enrich"`
+	// Policy는 결과를 게시할지 판단하는 기준입니다. 위반하면 아무것도 쓰지 않고 오류를 반환하여 호출이 실패합니다.
+	Policy internal.Policy `json:"policy"`
+}
+
+// enricher는 이벤트가 보강을 요청하면 새 Enricher의 Enrich를, 아니면 nil을 반환합니다.
+func (e Event) enricher() func(models.BlogPost) models.BlogPost {
+	if !e.Enrich {
+		return nil
+	}
+	return crawlers.NewEnricher().Enrich
+}
+
+// Report는 Lambda 응답으로 반환하는 크롤링 보고서입니다.
+type Report struct {
+	Mode      string          `json:"mode"`
+	DryRun    bool            `json:"dry_run"`
+	Since     string          `json:"since"`
+	Until     string          `json:"until,omitempty"`
+	Run       models.CrawlRun `json:"run"`
+	PostCount int             `json:"post_count"`
+	NewPosts  int             `json:"new_posts"`
+	Outputs   []string        `json:"outputs"`
+	// Partials는 worker 모드에서 저장하거나 merge 모드에서 읽은 partial 키 목록입니다.
+	Partials []string `json:"partials,omitempty"`
+	// Workers는 coordinator 모드에서 호출한 워커별 결과입니다.
+	Workers []WorkerResult `json:"workers,omitempty"`
+	// Health는 healthcheck 모드의 보고서입니다.
+	Health *health.Report `json:"health,omitempty"`
+}
+
+// WorkerResult는 coordinator가 호출한 워커 하나의 결과입니다.
+type WorkerResult struct {
+	Source    string `json:"source"`
+	PostCount int    `json:"post_count"`
+	Error     string `json:"error,omitempty"`
+}
+
+// window는 이벤트의 날짜 범위를 파싱합니다. Until은 해당 날짜의 끝 시각으로 변환됩니다.
+func (e Event) window(defaultSince string) (time.Time, time.Time, error) {
+	sinceStr := e.Since
+	if sinceStr == "" {
+		sinceStr = defaultSince
+	}
+	since, err := time.Parse("2006-01-02", sinceStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("since 파싱 실패: %w", err)
+	}
+
+	var until time.Time
+	if e.Until != "" {
+		day, err := time.Parse("2006-01-02", e.Until)
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("until 파싱 실패: %w", err)
+		}
+		until = day.Add(24*time.Hour - time.Nanosecond)
+		if until.Before(since) {
+			return time.Time{}, time.Time{}, fmt.Errorf("until(%s)이 since(%s)보다 이릅니다", e.Until, sinceStr)
+		}
+	}
+	return since, until, nil
+}
+
+// outputs는 업로드할 출력 형식을 검증하여 반환합니다.
+func (e Event) outputs() ([]string, error) {
+	if len(e.Outputs) == 0 {
+		return []string{"html"}, nil
+	}
+	for _, format := range e.Outputs {
+		if _, ok := internal.OutputFiles[format]; !ok {
+			return nil, fmt.Errorf("지원하지 않는 출력 형식: %s", format)
+		}
+	}
+	return e.Outputs, nil
+}
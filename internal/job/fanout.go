@@ -0,0 +1,208 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"hello-go/internal"
+	"hello-go/internal/crawlers"
+	"hello-go/internal/models"
+	"hello-go/internal/snapshot"
+)
+
+// partialPrefix는 워커가 저장하는 partial 객체 키의 접두사입니다.
+const partialPrefix = "partials/"
+
+// Partial은 워커 하나가 크롤링한 소스의 포스트 목록입니다.
+type Partial struct {
+	SourceID  string            `json:"source_id"`
+	CrawledAt time.Time         `json:"crawled_at"`
+	Source    models.SourceRun  `json:"source"`
+	Posts     []models.BlogPost `json:"posts"`
+}
+
+func partialKey(sourceID string) string {
+	return partialPrefix + sourceID + ".json"
+}
+
+// work는 Source 하나만 크롤링하여 partial로 저장합니다. 크롤링이 실패하면 기존 partial은 그대로 둡니다.
+func (h *Handler) work(event Event) (Report, error) {
+	entry, ok := crawlers.Find(event.Source)
+	if !ok {
+		return Report{}, fmt.Errorf("알 수 없는 소스: %q", event.Source)
+	}
+	since, until, err := event.window(h.filterDate)
+	if err != nil {
+		return Report{}, err
+	}
+	report := newReport(ModeWorker, event, since, until)
+
+	posts, run, err := internal.Collect(internal.Options{
+		FilterDate: report.Since,
+		Until:      until,
+		Enrich:     event.enricher(),
+	}, entry.NewCrawler(crawlers.Options{Since: since}))
+	report.Run = run
+	if err != nil {
+		return report, err
+	}
+	report.PostCount = len(posts)
+
+	source := run.Sources[0]
+	if source.Error != "" {
+		return report, fmt.Errorf("%s 크롤링 실패: %s", entry.ID, source.Error)
+	}
+	if event.DryRun {
+		return report, nil
+	}
+
+	data, err := json.Marshal(Partial{
+		SourceID:  entry.ID,
+		CrawledAt: run.FinishedAt,
+		Source:    source,
+		Posts:     posts,
+	})
+	if err != nil {
+		return report, fmt.Errorf("partial 인코딩 실패: %w", err)
+	}
+
+	key := partialKey(entry.ID)
+	if err := h.site.Write(key, data); err != nil {
+		return report, err
+	}
+	report.Partials = []string{key}
+	log.Printf("🧩 partial 저장: %s (%d개 포스트)", key, len(posts))
+
+	return report, nil
+}
+
+// merge는 저장된 partial을 모아 중복 제거, 필터링 후 결과를 게시합니다.
+// Sources가 지정되면 해당 소스의 partial만 병합합니다.
+func (h *Handler) merge(event Event) (Report, error) {
+	since, until, err := event.window(h.filterDate)
+	if err != nil {
+		return Report{}, err
+	}
+	outputs, err := event.outputs()
+	if err != nil {
+		return Report{}, err
+	}
+	report := newReport(ModeMerge, event, since, until)
+
+	keys, err := h.site.List(partialPrefix)
+	if err != nil {
+		return report, err
+	}
+
+	wanted := make(map[string]bool)
+	for _, id := range event.Sources {
+		wanted[id] = true
+	}
+
+	run := models.CrawlRun{StartedAt: time.Now(), FilterDate: report.Since}
+	var allPosts []models.BlogPost
+	for _, key := range keys {
+		id := strings.TrimSuffix(path.Base(key), ".json")
+		if len(wanted) > 0 && !wanted[id] {
+			continue
+		}
+
+		data, err := h.site.Read(key)
+		if err != nil {
+			return report, err
+		}
+		var partial Partial
+		if err := json.Unmarshal(data, &partial); err != nil {
+			return report, fmt.Errorf("partial 파싱 실패 (%s): %w", key, err)
+		}
+
+		allPosts = append(allPosts, partial.Posts...)
+		run.Sources = append(run.Sources, partial.Source)
+		report.Partials = append(report.Partials, key)
+		log.Printf("🧩 partial 병합: %s (%d개 포스트, %s 크롤링)", key, len(partial.Posts), partial.CrawledAt.Format(time.RFC3339))
+	}
+
+	if len(report.Partials) == 0 {
+		return report, errors.New("병합할 partial이 없습니다")
+	}
+
+	posts, err := internal.Finalize(allPosts, internal.Options{FilterDate: report.Since, Until: until}, &run)
+	if err != nil {
+		return report, err
+	}
+	posts, err = h.applyBaseline(event.Policy, posts, &run, since, until)
+	if err != nil {
+		report.Run = run
+		log.Printf("⚠️  게시 중단: %v", err)
+		return report, err
+	}
+	return h.publish(event, outputs, report, snapshot.Snapshot{Run: run, Posts: posts})
+}
+
+// coordinate는 소스마다 워커를 병렬로 호출한 뒤 병합합니다.
+// 실패한 워커의 소스는 이전에 저장된 partial로 병합되며, Policy.FailOnSourceError이면 병합하지 않고 실패합니다.
+func (h *Handler) coordinate(ctx context.Context, event Event) (Report, error) {
+	ids := event.Sources
+	if len(ids) == 0 {
+		for _, entry := range crawlers.Entries() {
+			ids = append(ids, entry.ID)
+		}
+	}
+
+	results := make([]WorkerResult, len(ids))
+	var wg sync.WaitGroup
+	for i, id := range ids {
+		wg.Add(1)
+		go func(i int, id string) {
+			defer wg.Done()
+			workerEvent := Event{
+				Mode:   ModeWorker,
+				Source: id,
+				Since:  event.Since,
+				Until:  event.Until,
+				DryRun: event.DryRun,
+				Enrich: event.Enrich,
+			}
+
+			results[i].Source = id
+			workerReport, err := h.invoker.Invoke(ctx, workerEvent)
+			if err != nil {
+				log.Printf("워커 %s 실패: %v", id, err)
+				results[i].Error = err.Error()
+				return
+			}
+			results[i].PostCount = workerReport.PostCount
+		}(i, id)
+	}
+	wg.Wait()
+
+	// 워커 실패를 허용하지 않으면 이전 partial로 병합하지 않음
+	if event.Policy.FailOnSourceError {
+		var violations []string
+		for _, result := range results {
+			if result.Error != "" {
+				violations = append(violations, fmt.Sprintf("워커 %s 실패: %s", result.Source, result.Error))
+			}
+		}
+		if len(violations) > 0 {
+			report := Report{Mode: ModeCoordinator, DryRun: event.DryRun, Workers: results}
+			return report, &internal.PolicyError{Violations: violations}
+		}
+	}
+
+	mergeEvent := event
+	mergeEvent.Mode = ModeMerge
+	mergeEvent.Sources = ids
+
+	report, err := h.merge(mergeEvent)
+	report.Mode = ModeCoordinator
+	report.Workers = results
+	return report, err
+}
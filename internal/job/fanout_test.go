@@ -0,0 +1,186 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"hello-go/internal"
+	"hello-go/internal/crawlers"
+	"hello-go/internal/models"
+	"hello-go/internal/storage"
+)
+
+// fixtureCrawler는 네트워크 없이 정해진 포스트나 오류를 반환하는 크롤러입니다.
+type fixtureCrawler struct {
+	name  string
+	posts []models.BlogPost
+	err   error
+}
+
+func (c fixtureCrawler) Crawl() ([]models.BlogPost, error) { return c.posts, c.err }
+func (c fixtureCrawler) GetSource() models.BlogSource {
+	return models.BlogSource{Name: c.name, URL: "https://" + c.name + ".example.com"}
+}
+
+// failing이 true이면 fixture-flaky 크롤러가 실패합니다.
+var failing bool
+
+func init() {
+	crawlers.Register(crawlers.Entry{
+		ID:   "fixture-ok",
+		Name: "정상",
+		NewCrawler: func(crawlers.Options) models.BlogCrawler {
+			return fixtureCrawler{name: "정상", posts: []models.BlogPost{
+				{Title: "정상 1", URL: "https://ok.example.com/1", Source: "정상", PublishedAt: day(3)},
+				{Title: "정상 2", URL: "https://ok.example.com/2", Source: "정상", PublishedAt: day(4)},
+			}}
+		},
+	})
+	crawlers.Register(crawlers.Entry{
+		ID:   "fixture-flaky",
+		Name: "불안정",
+		NewCrawler: func(crawlers.Options) models.BlogCrawler {
+			if failing {
+				return fixtureCrawler{name: "불안정", err: errors.New("목록 페이지 500")}
+			}
+			return fixtureCrawler{name: "불안정", posts: []models.BlogPost{
+				{Title: "불안정 1", URL: "https://flaky.example.com/1", Source: "불안정", PublishedAt: day(5)},
+			}}
+		},
+	})
+}
+
+func day(n int) time.Time {
+	return time.Date(2025, 3, n, 9, 0, 0, 0, time.UTC)
+}
+
+func newTestHandler(t *testing.T) (*Handler, *storage.FileStorage) {
+	t.Helper()
+	failing = false
+	site := storage.NewFileStorage(t.TempDir())
+	return NewHandler(site, "2025-01-01"), site
+}
+
+func readPartial(t *testing.T, site storage.Storage, id string) Partial {
+	t.Helper()
+	data, err := site.Read(partialKey(id))
+	if err != nil {
+		t.Fatalf("partial %s 읽기 실패: %v", id, err)
+	}
+	var partial Partial
+	if err := json.Unmarshal(data, &partial); err != nil {
+		t.Fatal(err)
+	}
+	return partial
+}
+
+func TestWorkerWritesPartial(t *testing.T) {
+	h, site := newTestHandler(t)
+
+	report, err := h.Handle(context.Background(), Event{Mode: ModeWorker, Source: "fixture-ok"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.PostCount != 2 || len(report.Partials) != 1 {
+		t.Errorf("report = %+v", report)
+	}
+	if partial := readPartial(t, site, "fixture-ok"); len(partial.Posts) != 2 {
+		t.Errorf("partial 포스트 %d개, want 2", len(partial.Posts))
+	}
+}
+
+func TestFailingWorkerKeepsPreviousPartial(t *testing.T) {
+	h, site := newTestHandler(t)
+	if _, err := h.Handle(context.Background(), Event{Mode: ModeWorker, Source: "fixture-flaky"}); err != nil {
+		t.Fatal(err)
+	}
+
+	failing = true
+	if _, err := h.Handle(context.Background(), Event{Mode: ModeWorker, Source: "fixture-flaky"}); err == nil {
+		t.Fatal("실패한 워커가 오류를 반환하지 않음")
+	}
+	if partial := readPartial(t, site, "fixture-flaky"); len(partial.Posts) != 1 {
+		t.Errorf("기존 partial이 바뀜: 포스트 %d개", len(partial.Posts))
+	}
+}
+
+func TestMergePublishesPartials(t *testing.T) {
+	h, site := newTestHandler(t)
+	for _, id := range []string{"fixture-ok", "fixture-flaky"} {
+		if _, err := h.Handle(context.Background(), Event{Mode: ModeWorker, Source: id}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	report, err := h.Handle(context.Background(), Event{
+		Mode:    ModeMerge,
+		Sources: []string{"fixture-ok", "fixture-flaky"},
+		Outputs: []string{"html", "json"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.PostCount != 3 {
+		t.Errorf("PostCount = %d, want 3", report.PostCount)
+	}
+	for _, key := range []string{"index.html", "posts.json"} {
+		if _, err := site.Read(key); err != nil {
+			t.Errorf("%s 없음: %v", key, err)
+		}
+	}
+	snapshots, err := site.List("snapshots/")
+	if err != nil || len(snapshots) != 1 {
+		t.Errorf("스냅샷 %v, %v", snapshots, err)
+	}
+}
+
+func TestCoordinatorMergesPreviousPartialOfFailedWorker(t *testing.T) {
+	h, site := newTestHandler(t)
+	sources := []string{"fixture-ok", "fixture-flaky"}
+	if _, err := h.Handle(context.Background(), Event{Mode: ModeCoordinator, Sources: sources}); err != nil {
+		t.Fatal(err)
+	}
+
+	failing = true
+	report, err := h.Handle(context.Background(), Event{Mode: ModeCoordinator, Sources: sources})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Workers) != 2 {
+		t.Fatalf("Workers = %+v", report.Workers)
+	}
+	for _, worker := range report.Workers {
+		if failed := worker.Error != ""; failed != (worker.Source == "fixture-flaky") {
+			t.Errorf("워커 %s Error = %q", worker.Source, worker.Error)
+		}
+	}
+	// 실패한 소스는 이전 partial로 병합됨
+	if report.PostCount != 3 {
+		t.Errorf("PostCount = %d, want 3", report.PostCount)
+	}
+	snapshots, _ := site.List("snapshots/")
+	if len(snapshots) != 2 {
+		t.Errorf("스냅샷 %d개, want 2", len(snapshots))
+	}
+}
+
+func TestCoordinatorFailOnSourceError(t *testing.T) {
+	h, site := newTestHandler(t)
+	failing = true
+
+	_, err := h.Handle(context.Background(), Event{
+		Mode:    ModeCoordinator,
+		Sources: []string{"fixture-ok", "fixture-flaky"},
+		Policy:  internal.Policy{FailOnSourceError: true},
+	})
+	var policyErr *internal.PolicyError
+	if !errors.As(err, &policyErr) {
+		t.Fatalf("err = %v, want PolicyError", err)
+	}
+	if _, err := site.Read("index.html"); !errors.Is(err, storage.ErrNotFound) {
+		t.Errorf("정책 위반인데 index.html이 게시됨: %v", err)
+	}
+}
@@ -0,0 +1,322 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"hello-go/internal"
+	"hello-go/internal/crawlers"
+	"hello-go/internal/health"
+	"hello-go/internal/images"
+	"hello-go/internal/models"
+	"hello-go/internal/snapshot"
+	"hello-go/internal/storage"
+)
+
+// defaultTolerance는 헬스체크에서 기대치 대비 허용하는 완성도 하락폭입니다.
+const defaultTolerance = 0.1
+
+// Invoker는 다른 실행 단위(예: Lambda 함수)에 이벤트를 보내 실행하기 위한 인터페이스입니다.
+type Invoker interface {
+	Invoke(ctx context.Context, event Event) (Report, error)
+}
+
+// InvokerFunc는 함수를 Invoker로 사용하기 위한 어댑터입니다.
+type InvokerFunc func(ctx context.Context, event Event) (Report, error)
+
+// Invoke는 f(ctx, event)를 호출합니다.
+func (f InvokerFunc) Invoke(ctx context.Context, event Event) (Report, error) {
+	return f(ctx, event)
+}
+
+// Handler는 이벤트의 모드에 따라 크롤링, 워커, 병합, 코디네이터를 실행합니다.
+type Handler struct {
+	site       storage.Storage
+	filterDate string
+	invoker    Invoker
+	images     *images.Processor
+	retention  snapshot.Retention
+}
+
+// NewHandler는 새로운 Handler 인스턴스를 생성합니다.
+// site는 스냅샷, partial, 출력물을 저장할 저장소이고, filterDate는 이벤트에 since가 없을 때의 기준 날짜입니다.
+// 워커 호출은 기본적으로 같은 프로세스에서 실행됩니다.
+func NewHandler(site storage.Storage, filterDate string) *Handler {
+	h := &Handler{site: site, filterDate: filterDate, retention: snapshot.Retention{Keep: snapshot.DefaultKeep}}
+	h.invoker = InvokerFunc(h.Handle)
+	return h
+}
+
+// SetInvoker는 coordinator 모드에서 워커를 호출할 Invoker를 설정합니다.
+func (h *Handler) SetInvoker(invoker Invoker) {
+	h.invoker = invoker
+}
+
+// SetImages는 HTML 출력의 포스트 이미지를 사본으로 바꿀 Processor를 설정합니다. nil이면 원본 주소를 그대로 사용합니다.
+func (h *Handler) SetImages(p *images.Processor) {
+	h.images = p
+}
+
+// SetRetention은 게시 후 정리할 스냅샷 보관 정책을 설정합니다. 기본값은 최근 snapshot.DefaultKeep개입니다.
+func (h *Handler) SetRetention(r snapshot.Retention) {
+	h.retention = r
+}
+
+// Handle은 이벤트를 실행하고 보고서를 반환합니다.
+func (h *Handler) Handle(ctx context.Context, event Event) (Report, error) {
+	switch event.Mode {
+	case "", ModeCrawl:
+		return h.crawl(event)
+	case ModeWorker:
+		return h.work(event)
+	case ModeMerge:
+		return h.merge(event)
+	case ModeCoordinator:
+		return h.coordinate(ctx, event)
+	case ModeHealthcheck:
+		return h.healthcheck(event)
+	default:
+		return Report{}, fmt.Errorf("지원하지 않는 모드: %s", event.Mode)
+	}
+}
+
+// newReport는 이벤트의 날짜 범위로 보고서의 공통 필드를 채웁니다.
+func newReport(mode string, event Event, since, until time.Time) Report {
+	report := Report{
+		Mode:   mode,
+		DryRun: event.DryRun,
+		Since:  since.Format("2006-01-02"),
+	}
+	if !until.IsZero() {
+		report.Until = until.Format("2006-01-02")
+	}
+	return report
+}
+
+// crawl은 호출 하나에서 소스들을 크롤링하고 결과를 게시합니다.
+func (h *Handler) crawl(event Event) (Report, error) {
+	since, until, err := event.window(h.filterDate)
+	if err != nil {
+		return Report{}, err
+	}
+	outputs, err := event.outputs()
+	if err != nil {
+		return Report{}, err
+	}
+	report := newReport("full", event, since, until)
+
+	previous, hasPrevious, err := snapshot.NewStore(h.site).Latest()
+	if err != nil {
+		return report, err
+	}
+
+	// 증분 크롤링: 직전 실행 하루 전부터만 크롤링하고 나머지는 직전 스냅샷에서 가져옴
+	crawlSince := since
+	incremental := hasPrevious && !event.FullRecrawl
+	if incremental {
+		reason, err := uncovered(previous, event.Sources, since)
+		if err != nil {
+			return report, err
+		}
+		if reason != "" {
+			log.Printf("ℹ️  %s, 전체 크롤링합니다.", reason)
+			incremental = false
+		}
+	}
+	if incremental {
+		report.Mode = "incremental"
+		if last := previous.Run.StartedAt.AddDate(0, 0, -1).Truncate(24 * time.Hour); last.After(since) {
+			crawlSince = last
+		}
+	}
+
+	blogCrawlers, err := crawlers.Select(event.Sources, crawlers.Options{Since: crawlSince})
+	if err != nil {
+		return report, err
+	}
+
+	log.Printf("🚀 %s 크롤링 시작 (소스: %v, 크롤링 기준: %s)", report.Mode, event.Sources, crawlSince.Format("2006-01-02"))
+	posts, run, err := internal.Collect(internal.Options{
+		FilterDate: crawlSince.Format("2006-01-02"),
+		Until:      until,
+		Enrich:     event.enricher(),
+	}, blogCrawlers...)
+	if err != nil {
+		return report, err
+	}
+	run.FilterDate = report.Since
+
+	// 직전 포스트를 합치기 전에 이번에 크롤링한 기간의 포스트 수끼리 비교
+	posts, err = h.applyBaseline(event.Policy, posts, &run, crawlSince, until)
+	if err != nil {
+		report.Run = run
+		log.Printf("⚠️  게시 중단: %v", err)
+		return report, err
+	}
+
+	if hasPrevious {
+		posts = carryOver(previous.Posts, posts, blogCrawlers, incremental, since, until)
+	}
+
+	return h.publish(event, outputs, report, snapshot.Snapshot{Run: run, Posts: posts})
+}
+
+// uncovered는 직전 스냅샷이 이번 크롤링의 기간이나 소스를 모두 담고 있지 않으면 그 이유를 반환합니다.
+// 직전 실행의 수집 기준일이 since보다 늦거나 직전 실행에 없던 소스가 있으면 빠진 포스트를 직전 스냅샷에서 가져올 수 없습니다.
+func uncovered(previous snapshot.Snapshot, sourceIDs []string, since time.Time) (string, error) {
+	filterDate, err := time.Parse("2006-01-02", previous.Run.FilterDate)
+	if err != nil || filterDate.After(since) {
+		return fmt.Sprintf("직전 스냅샷의 수집 기준일(%s)이 %s보다 늦음", previous.Run.FilterDate, since.Format("2006-01-02")), nil
+	}
+
+	entries, err := crawlers.SelectEntries(sourceIDs)
+	if err != nil {
+		return "", err
+	}
+	crawled := make(map[string]bool)
+	for _, source := range previous.Run.Sources {
+		crawled[source.Name] = true
+	}
+	for _, entry := range entries {
+		if !crawled[entry.Name] {
+			return fmt.Sprintf("직전 스냅샷에 %s 소스가 없음", entry.Name), nil
+		}
+	}
+	return "", nil
+}
+
+// applyBaseline은 수집 기준일이 같은 직전 스냅샷에서 [since, until]에 발행된 포스트를 기준으로 포스트 수가 급감한 소스를 처리합니다.
+// 기준일이 다른 스냅샷이나 이번에 크롤링하지 않은 기간의 포스트와는 비교하지 않습니다.
+func (h *Handler) applyBaseline(policy internal.Policy, posts []models.BlogPost, run *models.CrawlRun, since, until time.Time) ([]models.BlogPost, error) {
+	if policy.MinSourceRatio <= 0 {
+		return posts, nil
+	}
+	previous, ok, err := snapshot.NewStore(h.site).LatestSince(run.FilterDate)
+	if err != nil || !ok {
+		return posts, err
+	}
+
+	var baseline []models.BlogPost
+	for _, post := range previous.Posts {
+		if !post.PublishedAt.Before(since) && (until.IsZero() || !post.PublishedAt.After(until)) {
+			baseline = append(baseline, post)
+		}
+	}
+	return policy.ApplyBaseline(baseline, posts, run)
+}
+
+// publish는 직전 스냅샷과 비교하여 새 포스트를 표시하고, 출력물을 모두 저장한 뒤 스냅샷을 저장합니다.
+// 출력물 저장에 실패하면 스냅샷을 남기지 않아 다음 실행에서도 같은 포스트를 새 포스트로 표시합니다.
+// 결과가 이벤트의 정책을 위반하면 dry-run이어도 오류를 반환합니다. 포스트 수 급감 확인은 호출하는 쪽에서 먼저 합니다.
+func (h *Handler) publish(event Event, outputs []string, report Report, snap snapshot.Snapshot) (Report, error) {
+	report.Run = snap.Run
+	report.PostCount = len(snap.Posts)
+	if err := event.Policy.Check(snap.Posts, snap.Run); err != nil {
+		log.Printf("⚠️  게시 중단: %v", err)
+		return report, err
+	}
+
+	snapStore := snapshot.NewStore(h.site)
+	snapStore.SetRetention(h.retention)
+	diff, err := snapStore.Mark(&snap)
+	if err != nil {
+		return report, err
+	}
+	report.NewPosts = len(diff.NewPosts())
+
+	if event.DryRun {
+		log.Printf("🧪 dry-run: %d개 포스트, 새 포스트 %d개 (업로드 생략)", report.PostCount, report.NewPosts)
+		return report, nil
+	}
+
+	for _, format := range outputs {
+		rendered := snap
+		// 스냅샷은 원본 이미지 주소를 유지하여 비교 결과가 바뀌지 않게 하고, HTML에만 사본을 사용
+		if format == "html" && h.images != nil {
+			rendered.Posts, err = h.images.Apply(snap.Posts)
+			if err != nil {
+				return report, err
+			}
+		}
+		data, err := internal.Render(format, rendered)
+		if err != nil {
+			return report, err
+		}
+		key := internal.OutputFiles[format]
+		if err := h.site.Write(key, data); err != nil {
+			return report, err
+		}
+		report.Outputs = append(report.Outputs, key)
+		log.Printf("✅ 업로드되었습니다: %s", key)
+	}
+
+	return report, snapStore.Commit(snap, diff)
+}
+
+// healthcheck는 크롤러들을 실행하여 저장된 기대치와 비교하고 보고서를 저장합니다. 비정상 소스가 있으면 오류를 반환합니다.
+func (h *Handler) healthcheck(event Event) (Report, error) {
+	since, until, err := event.window(h.filterDate)
+	if err != nil {
+		return Report{}, err
+	}
+	report := newReport(ModeHealthcheck, event, since, until)
+
+	entries, err := crawlers.SelectEntries(event.Sources)
+	if err != nil {
+		return report, err
+	}
+	expectations, err := health.LoadExpectations(h.site)
+	if err != nil {
+		return report, err
+	}
+
+	checker := health.Checker{Expectations: expectations, Tolerance: defaultTolerance}
+	result := checker.Run(entries, since)
+	report.Health = &result
+
+	if !event.DryRun {
+		if err := health.SaveReport(h.site, result); err != nil {
+			return report, err
+		}
+		report.Outputs = []string{health.ReportKey}
+	}
+
+	if !result.Healthy {
+		var failed []string
+		for _, source := range result.Sources {
+			if !source.Healthy {
+				failed = append(failed, source.ID)
+			}
+		}
+		return report, fmt.Errorf("비정상 소스: %v", failed)
+	}
+	return report, nil
+}
+
+// carryOver는 이번에 크롤링하지 않은 포스트를 직전 스냅샷에서 가져와 합칩니다.
+// 증분 크롤링이면 직전 포스트 전체를, 일부 소스만 다시 크롤링했다면 나머지 소스의 포스트를 가져옵니다.
+func carryOver(previous, current []models.BlogPost, blogCrawlers []models.BlogCrawler, incremental bool, since, until time.Time) []models.BlogPost {
+	crawled := make(map[string]bool)
+	for _, c := range blogCrawlers {
+		crawled[c.GetSource().Name] = true
+	}
+
+	var kept []models.BlogPost
+	for _, post := range previous {
+		if !incremental && crawled[post.Source] {
+			continue
+		}
+		if post.PublishedAt.Before(since) || (!until.IsZero() && post.PublishedAt.After(until)) {
+			continue
+		}
+		kept = append(kept, post)
+	}
+	if len(kept) == 0 {
+		return current
+	}
+
+	log.Printf("📦 직전 스냅샷에서 %d개 포스트를 가져옵니다.", len(kept))
+	return snapshot.Merge(kept, current)
+}
@@ -0,0 +1,182 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"hello-go/internal"
+	"hello-go/internal/crawlers"
+	"hello-go/internal/models"
+	"hello-go/internal/snapshot"
+	"hello-go/internal/storage"
+)
+
+// recentCount는 fixture-recent 크롤러가 반환하는 최근 포스트 수입니다.
+var recentCount int
+
+func init() {
+	crawlers.Register(crawlers.Entry{
+		ID:   "fixture-recent",
+		Name: "최근",
+		NewCrawler: func(crawlers.Options) models.BlogCrawler {
+			posts := make([]models.BlogPost, recentCount)
+			for i := range posts {
+				posts[i] = models.BlogPost{
+					Title:       fmt.Sprintf("최근 %d", i),
+					URL:         fmt.Sprintf("https://recent.example.com/%d", i),
+					Source:      "최근",
+					PublishedAt: time.Now().Add(-time.Duration(i+1) * time.Hour),
+				}
+			}
+			return fixtureCrawler{name: "최근", posts: posts}
+		},
+	})
+}
+
+func TestIncrementalCrawlChecksBaselineBeforeCarryOver(t *testing.T) {
+	h, _ := newTestHandler(t)
+	event := Event{
+		Mode:    ModeCrawl,
+		Sources: []string{"fixture-recent"},
+		Policy:  internal.Policy{MinSourceRatio: 0.5},
+	}
+
+	recentCount = 4
+	if _, err := h.Handle(context.Background(), event); err != nil {
+		t.Fatal(err)
+	}
+
+	// 직전 포스트를 합치면 4개 그대로이지만, 이번에 크롤링한 기간에는 1개뿐
+	recentCount = 1
+	report, err := h.Handle(context.Background(), event)
+	var policyErr *internal.PolicyError
+	if !errors.As(err, &policyErr) {
+		t.Fatalf("err = %v, want PolicyError", err)
+	}
+	if report.Mode != "incremental" {
+		t.Errorf("Mode = %s, want incremental", report.Mode)
+	}
+}
+
+func TestBaselineIgnoresSnapshotsOfOtherWindow(t *testing.T) {
+	h, _ := newTestHandler(t)
+	event := Event{
+		Mode:        ModeCrawl,
+		Sources:     []string{"fixture-ok"},
+		Since:       "2025-01-01",
+		FullRecrawl: true,
+		Policy:      internal.Policy{MinSourceRatio: 0.9},
+	}
+	if _, err := h.Handle(context.Background(), event); err != nil {
+		t.Fatal(err)
+	}
+
+	// 기간이 짧아 포스트가 1개로 줄어도 기준일이 다른 스냅샷과는 비교하지 않음
+	event.Since = "2025-03-04"
+	report, err := h.Handle(context.Background(), event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.PostCount != 1 {
+		t.Errorf("PostCount = %d, want 1", report.PostCount)
+	}
+
+	// 같은 기준일의 스냅샷과는 비교
+	event.Since = "2025-01-01"
+	if _, err := h.Handle(context.Background(), event); err != nil {
+		t.Fatalf("같은 포스트 수인데 실패: %v", err)
+	}
+}
+
+func TestIncrementalFallsBackToFullCrawl(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous Event
+	}{
+		// 직전 스냅샷에는 3월 4일 이후 포스트만 있음
+		{"later filter date", Event{Mode: ModeCrawl, Sources: []string{"fixture-ok", "fixture-flaky"}, Since: "2025-03-04"}},
+		// 직전 스냅샷에는 fixture-flaky 포스트가 없음
+		{"missing source", Event{Mode: ModeCrawl, Sources: []string{"fixture-ok"}, Since: "2025-01-01"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, _ := newTestHandler(t)
+			if _, err := h.Handle(context.Background(), tt.previous); err != nil {
+				t.Fatal(err)
+			}
+
+			report, err := h.Handle(context.Background(), Event{
+				Mode:    ModeCrawl,
+				Sources: []string{"fixture-ok", "fixture-flaky"},
+				Since:   "2025-01-01",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if report.Mode != "full" || report.PostCount != 3 {
+				t.Errorf("Mode = %s, PostCount = %d, want full, 3", report.Mode, report.PostCount)
+			}
+		})
+	}
+}
+
+func TestIncrementalWhenPreviousSnapshotCovers(t *testing.T) {
+	h, _ := newTestHandler(t)
+	event := Event{Mode: ModeCrawl, Sources: []string{"fixture-ok", "fixture-flaky"}, Since: "2025-01-01"}
+	if _, err := h.Handle(context.Background(), event); err != nil {
+		t.Fatal(err)
+	}
+
+	// 일부 소스만 다시 크롤링해도 직전 스냅샷이 담고 있으므로 증분 크롤링
+	event.Sources = []string{"fixture-ok"}
+	report, err := h.Handle(context.Background(), event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Mode != "incremental" || report.PostCount != 3 {
+		t.Errorf("Mode = %s, PostCount = %d, want incremental, 3", report.Mode, report.PostCount)
+	}
+}
+
+// failingWrites는 key에 쓰기가 실패하는 저장소입니다.
+type failingWrites struct {
+	storage.Storage
+	key string
+}
+
+func (f failingWrites) Write(key string, data []byte) error {
+	if key == f.key {
+		return errors.New("업로드 실패")
+	}
+	return f.Storage.Write(key, data)
+}
+
+func TestFailedUploadKeepsPreviousSnapshot(t *testing.T) {
+	h, site := newTestHandler(t)
+	event := Event{Mode: ModeCrawl, Sources: []string{"fixture-ok"}, Since: "2025-01-01", FullRecrawl: true}
+	if _, err := h.Handle(context.Background(), event); err != nil {
+		t.Fatal(err)
+	}
+
+	// 출력물 업로드에 실패하면 스냅샷을 남기지 않음
+	event.Sources = []string{"fixture-ok", "fixture-flaky"}
+	failed := NewHandler(failingWrites{Storage: site, key: internal.OutputFiles["html"]}, "2025-01-01")
+	if _, err := failed.Handle(context.Background(), event); err == nil {
+		t.Fatal("업로드 실패인데 오류 없음")
+	}
+	if keys, err := snapshot.NewStore(site).Keys(); err != nil || len(keys) != 1 {
+		t.Fatalf("스냅샷 %v (%v), want 1개", keys, err)
+	}
+
+	// 다음 실행에서도 같은 포스트를 새 포스트로 표시
+	report, err := h.Handle(context.Background(), event)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.NewPosts != 1 {
+		t.Errorf("NewPosts = %d, want 1", report.NewPosts)
+	}
+}
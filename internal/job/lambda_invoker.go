@@ -0,0 +1,46 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+)
+
+// LambdaInvoker는 Lambda 함수를 동기 호출하여 이벤트를 실행합니다.
+type LambdaInvoker struct {
+	client       *lambda.Client
+	functionName string
+}
+
+// NewLambdaInvoker는 새로운 LambdaInvoker 인스턴스를 생성합니다.
+func NewLambdaInvoker(client *lambda.Client, functionName string) *LambdaInvoker {
+	return &LambdaInvoker{client: client, functionName: functionName}
+}
+
+// Invoke는 Lambda 함수를 호출하고 응답을 보고서로 파싱합니다.
+func (i *LambdaInvoker) Invoke(ctx context.Context, event Event) (Report, error) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return Report{}, fmt.Errorf("이벤트 인코딩 실패: %w", err)
+	}
+
+	out, err := i.client.Invoke(ctx, &lambda.InvokeInput{
+		FunctionName: aws.String(i.functionName),
+		Payload:      payload,
+	})
+	if err != nil {
+		return Report{}, fmt.Errorf("Lambda 호출 실패: %w", err)
+	}
+	if out.FunctionError != nil {
+		return Report{}, fmt.Errorf("Lambda 함수 오류 (%s): %s", aws.ToString(out.FunctionError), string(out.Payload))
+	}
+
+	var report Report
+	if err := json.Unmarshal(out.Payload, &report); err != nil {
+		return Report{}, fmt.Errorf("Lambda 응답 파싱 실패: %w", err)
+	}
+	return report, nil
+}
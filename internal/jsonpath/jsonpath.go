@@ -0,0 +1,249 @@
+// Package jsonpath는 JSON으로 디코딩한 값(map[string]any, []any)에서 JSONPath 형식의 경로로 값을 찾습니다.
+//
+// 지원하는 문법:
+//
+//	$.a.b        키
+//	a["b c"]     따옴표로 감싼 키
+//	a[0], a[-1]  인덱스 (음수는 뒤에서부터)
+//	a[*], a.*    모든 원소 또는 모든 값
+//	$..key       하위 모든 깊이에서 key
+//
+// 경로를 따라가는 중 문자열 값을 만나면 JSON으로 디코딩을 시도합니다.
+// React Query dehydrated state처럼 JSON이 문자열로 한 번 더 감싸인 경우를 그대로 따라갈 수 있습니다.
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type stepKind int
+
+const (
+	stepKey stepKind = iota
+	stepIndex
+	stepWildcard
+	stepRecursive
+)
+
+type step struct {
+	kind  stepKind
+	key   string
+	index int
+}
+
+// Path는 컴파일된 경로입니다.
+type Path struct {
+	expr  string
+	steps []step
+}
+
+// Compile은 경로 표현식을 컴파일합니다.
+func Compile(expr string) (*Path, error) {
+	p := &Path{expr: expr}
+	rest := strings.TrimSpace(expr)
+	rest = strings.TrimPrefix(rest, "$")
+
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			key, remaining := readKey(rest[2:])
+			if key == "" {
+				return nil, fmt.Errorf("jsonpath %q: '..' 뒤에 키가 필요합니다", expr)
+			}
+			p.steps = append(p.steps, step{kind: stepRecursive, key: key})
+			rest = remaining
+		case strings.HasPrefix(rest, "."):
+			key, remaining := readKey(rest[1:])
+			if key == "" {
+				return nil, fmt.Errorf("jsonpath %q: '.' 뒤에 키가 필요합니다", expr)
+			}
+			p.steps = append(p.steps, keyStep(key))
+			rest = remaining
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("jsonpath %q: 닫는 ']'가 없습니다", expr)
+			}
+			s, err := bracketStep(strings.TrimSpace(rest[1:end]))
+			if err != nil {
+				return nil, fmt.Errorf("jsonpath %q: %w", expr, err)
+			}
+			p.steps = append(p.steps, s)
+			rest = rest[end+1:]
+		default:
+			// 맨 앞의 '$.' 없이 시작하는 키
+			key, remaining := readKey(rest)
+			p.steps = append(p.steps, keyStep(key))
+			rest = remaining
+		}
+	}
+	return p, nil
+}
+
+// MustCompile은 Compile과 같지만 실패하면 panic합니다. 패키지 변수 초기화에 사용합니다.
+func MustCompile(expr string) *Path {
+	p, err := Compile(expr)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func readKey(s string) (string, string) {
+	end := strings.IndexAny(s, ".[")
+	if end < 0 {
+		return s, ""
+	}
+	return s[:end], s[end:]
+}
+
+func keyStep(key string) step {
+	if key == "*" {
+		return step{kind: stepWildcard}
+	}
+	return step{kind: stepKey, key: key}
+}
+
+func bracketStep(inner string) (step, error) {
+	if inner == "*" {
+		return step{kind: stepWildcard}, nil
+	}
+	if len(inner) >= 2 && (inner[0] == '\'' || inner[0] == '"') && inner[len(inner)-1] == inner[0] {
+		return step{kind: stepKey, key: inner[1 : len(inner)-1]}, nil
+	}
+	index, err := strconv.Atoi(inner)
+	if err != nil {
+		return step{}, fmt.Errorf("잘못된 인덱스: [%s]", inner)
+	}
+	return step{kind: stepIndex, index: index}, nil
+}
+
+// String은 원래 표현식을 반환합니다.
+func (p *Path) String() string {
+	return p.expr
+}
+
+// Find는 경로와 일치하는 모든 값을 문서 순서대로 반환합니다.
+func (p *Path) Find(root any) []any {
+	values := []any{root}
+	for _, s := range p.steps {
+		var next []any
+		for _, v := range values {
+			next = append(next, s.apply(v)...)
+		}
+		values = next
+		if len(values) == 0 {
+			break
+		}
+	}
+	return values
+}
+
+// First는 경로와 일치하는 첫 값을 반환합니다.
+func (p *Path) First(root any) (any, bool) {
+	values := p.Find(root)
+	if len(values) == 0 {
+		return nil, false
+	}
+	return values[0], true
+}
+
+func (s step) apply(v any) []any {
+	v = decodeString(v)
+	switch s.kind {
+	case stepKey:
+		if m, ok := v.(map[string]any); ok {
+			if child, ok := m[s.key]; ok {
+				return []any{child}
+			}
+		}
+	case stepIndex:
+		if a, ok := v.([]any); ok {
+			i := s.index
+			if i < 0 {
+				i += len(a)
+			}
+			if i >= 0 && i < len(a) {
+				return []any{a[i]}
+			}
+		}
+	case stepWildcard:
+		return children(v)
+	case stepRecursive:
+		var found []any
+		collect(v, s.key, &found)
+		return found
+	}
+	return nil
+}
+
+// children은 배열의 원소 또는 객체의 값을 키 순서로 반환합니다.
+func children(v any) []any {
+	switch t := v.(type) {
+	case []any:
+		return t
+	case map[string]any:
+		keys := make([]string, 0, len(t))
+		for k := range t {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		values := make([]any, 0, len(keys))
+		for _, k := range keys {
+			values = append(values, t[k])
+		}
+		return values
+	}
+	return nil
+}
+
+func collect(v any, key string, found *[]any) {
+	v = decodeString(v)
+	if m, ok := v.(map[string]any); ok {
+		if child, ok := m[key]; ok {
+			*found = append(*found, child)
+		}
+	}
+	for _, child := range children(v) {
+		collect(child, key, found)
+	}
+}
+
+// decodeString은 JSON 객체나 배열을 담은 문자열을 디코딩합니다. 그 외의 값은 그대로 반환합니다.
+func decodeString(v any) any {
+	s, ok := v.(string)
+	if !ok {
+		return v
+	}
+	trimmed := strings.TrimSpace(s)
+	if trimmed == "" || (trimmed[0] != '{' && trimmed[0] != '[') {
+		return v
+	}
+	var decoded any
+	if err := json.Unmarshal([]byte(trimmed), &decoded); err != nil {
+		return v
+	}
+	return decoded
+}
+
+// String은 값을 문자열로 변환합니다. 숫자는 정수면 소수점 없이 변환하고, 객체와 배열은 빈 문자열을 반환합니다.
+func String(v any) string {
+	switch t := v.(type) {
+	case string:
+		return t
+	case float64:
+		if t == float64(int64(t)) {
+			return strconv.FormatInt(int64(t), 10)
+		}
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	case json.Number:
+		return t.String()
+	}
+	return ""
+}
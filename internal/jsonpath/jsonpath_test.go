@@ -0,0 +1,110 @@
+package jsonpath
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+const document = `{
+  "props": {
+    "pageProps": {
+      "title": "목록",
+      "posts": [
+        {"id": 1, "title": "첫 글", "author": {"name": "김"}, "tags": ["go", "aws"]},
+        {"id": 2.5, "title": "둘째 글", "author": {"name": "이"}, "draft": true},
+        {"id": 3, "title": "셋째 글", "meta data": {"views": 10}}
+      ],
+      "state": "{\"queries\": [{\"data\": {\"title\": \"감싼 JSON\"}}]}"
+    }
+  }
+}`
+
+func decode(t *testing.T) any {
+	t.Helper()
+	var v any
+	if err := json.Unmarshal([]byte(document), &v); err != nil {
+		t.Fatal(err)
+	}
+	return v
+}
+
+func TestFind(t *testing.T) {
+	root := decode(t)
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{"$.props.pageProps.title", "[목록]"},
+		{"props.pageProps.title", "[목록]"},
+		{"$.props.pageProps.posts[0].title", "[첫 글]"},
+		{"$.props.pageProps.posts[-1].title", "[셋째 글]"},
+		{"$.props.pageProps.posts[9].title", "[]"},
+		{"$.props.pageProps.posts[*].author.name", "[김 이]"},
+		{"$.props.pageProps.posts.*.id", "[1 2.5 3]"},
+		{`$.props.pageProps.posts[2]["meta data"].views`, "[10]"},
+		{"$.props.pageProps.posts[0].tags[*]", "[go aws]"},
+		// 하위 모든 깊이에서 문서 순서대로 찾음
+		{"$..name", "[김 이]"},
+		{"$.props..posts[*].title", "[첫 글 둘째 글 셋째 글]"},
+		// 문자열로 감싼 JSON도 따라감
+		{"$.props.pageProps.state.queries[0].data.title", "[감싼 JSON]"},
+		{"$..queries[*].data.title", "[감싼 JSON]"},
+		{"$.props.missing.title", "[]"},
+	}
+	for _, tt := range tests {
+		p, err := Compile(tt.expr)
+		if err != nil {
+			t.Errorf("Compile(%q): %v", tt.expr, err)
+			continue
+		}
+		var got []string
+		for _, v := range p.Find(root) {
+			got = append(got, String(v))
+		}
+		if fmt.Sprint(got) != tt.want {
+			t.Errorf("%s = %v, want %s", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, expr := range []string{"$..", "$.a.", "$.a[0", "$.a[x]"} {
+		if _, err := Compile(expr); err == nil {
+			t.Errorf("Compile(%q): 오류 없음", expr)
+		}
+	}
+}
+
+func TestFirst(t *testing.T) {
+	root := decode(t)
+
+	v, ok := MustCompile("$..title").First(root)
+	if !ok || v != "목록" {
+		t.Errorf("First = %v, %v, want 목록", v, ok)
+	}
+	if _, ok := MustCompile("$..missing").First(root); ok {
+		t.Error("없는 경로인데 ok")
+	}
+}
+
+func TestString(t *testing.T) {
+	tests := []struct {
+		v    any
+		want string
+	}{
+		{"글", "글"},
+		{float64(1700000000000), "1700000000000"},
+		{2.5, "2.5"},
+		{true, "true"},
+		{json.Number("42"), "42"},
+		{map[string]any{"a": 1}, ""},
+		{[]any{"a"}, ""},
+		{nil, ""},
+	}
+	for _, tt := range tests {
+		if got := String(tt.v); got != tt.want {
+			t.Errorf("String(%v) = %q, want %q", tt.v, got, tt.want)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package models
 
 import (
+	"iter"
 	"time"
 )
 
@@ -10,10 +11,26 @@ type BlogPost struct {
 	URL         string    `json:"url"`
 	Author      string    `json:"author"`
 	PublishedAt time.Time `json:"published_at"`
-	Summary     string    `json:"summary"`
-	Source      string    `json:"source"`
-	Category    string    `json:"category"`
-	Image       string    `json:"image"`
+	// UpdatedAt은 포스트 페이지의 메타데이터에 있는 마지막 수정 시각입니다.
+	UpdatedAt time.Time `json:"updated_at,omitzero"`
+	Summary   string    `json:"summary"`
+	Source    string    `json:"source"`
+	Category  string    `json:"category"`
+	Image     string    `json:"image"`
+	// ImageWidth, ImageHeight, ImageColor는 이미지 사본의 크기와 대표 색이며, 이미지를 받기 전 자리를 잡는 데 사용합니다.
+	// 사본을 만들지 않은 이미지는 비어 있습니다.
+	ImageWidth  int    `json:"image_width,omitempty"`
+	ImageHeight int    `json:"image_height,omitempty"`
+	ImageColor  string `json:"image_color,omitempty"`
+	// ImageWebP는 이미지 사본의 WebP 주소이며, JPEG 사본보다 작을 때만 채워집니다.
+	ImageWebP string `json:"image_webp,omitempty"`
+	// Tags는 블로그에서 포스트에 붙인 태그 목록입니다.
+	Tags []string `json:"tags,omitempty"`
+	// Provenance는 상세 페이지 보강 시 필드 이름(author, published_at, updated_at, summary, tags, image)별로 값을 가져온 출처입니다.
+	// 목록에서 가져온 값을 그대로 둔 필드는 "listing"입니다.
+	Provenance map[string]string `json:"provenance,omitempty"`
+	// IsNew는 이전 실행의 스냅샷에 없던 포스트인지 여부입니다.
+	IsNew bool `json:"is_new,omitempty"`
 }
 
 // BlogSource는 블로그 소스 정보를 담는 구조체입니다.
@@ -23,11 +40,19 @@ type BlogSource struct {
 }
 
 // BlogCrawler는 블로그 크롤링을 위한 인터페이스입니다.
+// Crawl은 일부 페이지만 실패하면 그때까지 찾은 포스트와 오류를 함께 반환할 수 있습니다.
 type BlogCrawler interface {
 	Crawl() ([]BlogPost, error)
 	GetSource() BlogSource
 }
 
+// StreamingCrawler는 포스트를 찾는 대로 하나씩 내보내는 크롤러입니다.
+// 오류를 내보낸 뒤에는 더 이상 포스트를 내보내지 않으며, 소비자가 중단하면 남은 요청을 하지 않습니다.
+type StreamingCrawler interface {
+	BlogCrawler
+	Stream() iter.Seq2[BlogPost, error]
+}
+
 // BlogPostFilter는 블로그 포스트 필터링을 위한 인터페이스입니다.
 type BlogPostFilter interface {
 	Filter(posts []BlogPost) []BlogPost
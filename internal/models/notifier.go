@@ -0,0 +1,8 @@
+package models
+
+// Notifier는 새 포스트 알림을 보내기 위한 인터페이스입니다.
+type Notifier interface {
+	// Notify는 포스트 목록을 메시지 하나로 보냅니다.
+	Notify(posts []BlogPost) error
+	Name() string
+}
@@ -0,0 +1,59 @@
+package models
+
+import (
+	"time"
+)
+
+// CrawlRun은 한 번의 크롤링 실행 정보를 담는 구조체입니다.
+type CrawlRun struct {
+	StartedAt     time.Time   `json:"started_at"`
+	FinishedAt    time.Time   `json:"finished_at"`
+	FilterDate    string      `json:"filter_date"`
+	TotalCount    int         `json:"total_count"`
+	FilteredCount int         `json:"filtered_count"`
+	UniqueCount   int         `json:"unique_count"`
+	Sources       []SourceRun `json:"sources"`
+	Warnings      []string    `json:"warnings,omitempty"`
+	// Scheduler는 크롤링 중 외부 요청의 동시성 사용량입니다.
+	Scheduler *SchedulerStats `json:"scheduler,omitempty"`
+	// Cache는 HTTP 캐시를 사용한 경우 소스별 적중률입니다.
+	Cache []CacheStats `json:"cache,omitempty"`
+}
+
+// SourceRun은 소스별 크롤링 결과를 담는 구조체입니다.
+type SourceRun struct {
+	Name  string `json:"name"`
+	URL   string `json:"url"`
+	Count int    `json:"count"`
+	Error string `json:"error,omitempty"`
+}
+
+// SchedulerStats는 한 번의 실행 동안 동시 요청 제한의 사용량입니다.
+type SchedulerStats struct {
+	MaxInFlight  int `json:"max_in_flight"`
+	PerHost      int `json:"per_host"`
+	Requests     int `json:"requests"`
+	PeakInFlight int `json:"peak_in_flight"`
+	// Utilisation은 평균 동시 요청 수를 MaxInFlight로 나눈 값입니다. (0~1)
+	Utilisation float64 `json:"utilisation"`
+	// AvgQueueMs, MaxQueueMs는 요청이 슬롯을 얻기까지 기다린 시간입니다.
+	AvgQueueMs int64       `json:"avg_queue_ms"`
+	MaxQueueMs int64       `json:"max_queue_ms"`
+	Hosts      []HostStats `json:"hosts,omitempty"`
+}
+
+// HostStats는 호스트별 요청 사용량입니다.
+type HostStats struct {
+	Host         string `json:"host"`
+	Requests     int    `json:"requests"`
+	PeakInFlight int    `json:"peak_in_flight"`
+	AvgQueueMs   int64  `json:"avg_queue_ms"`
+}
+
+// CacheStats는 소스별 HTTP 캐시 적중률입니다. 304 응답으로 저장된 본문을 사용한 요청이 적중입니다.
+type CacheStats struct {
+	Source   string  `json:"source"`
+	Requests int     `json:"requests"`
+	Hits     int     `json:"hits"`
+	HitRatio float64 `json:"hit_ratio"`
+}
@@ -0,0 +1,62 @@
+package notifiers
+
+import (
+	"net/http"
+	"text/template"
+	"time"
+
+	"hello-go/internal/models"
+)
+
+// discordContentLimit은 Discord 메시지 content의 최대 글자 수입니다.
+const discordContentLimit = 2000
+
+const defaultDiscordTemplate = `**🆕 새 기술 블로그 포스트 {{.Count}}개**
+{{range .Posts}}• [{{.Title}}](<{{.URL}}>) - {{.Source}} · {{.Category}} ({{date .PublishedAt}})
+{{end}}`
+
+// DiscordNotifier는 Discord Webhook으로 알림을 보냅니다.
+type DiscordNotifier struct {
+	name   string
+	url    string
+	client *http.Client
+	tmpl   *template.Template
+	retry  RetryPolicy
+}
+
+// NewDiscordNotifier는 새로운 DiscordNotifier 인스턴스를 생성합니다. tmpl이 비어 있으면 기본 템플릿을 사용합니다.
+func NewDiscordNotifier(name, url, tmpl string, retry RetryPolicy) (*DiscordNotifier, error) {
+	parsed, err := parseTemplate(name, tmpl, defaultDiscordTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return &DiscordNotifier{
+		name:   name,
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+		tmpl:   parsed,
+		retry:  retry,
+	}, nil
+}
+
+// Name은 알림 채널 이름을 반환합니다.
+func (n *DiscordNotifier) Name() string {
+	return n.name
+}
+
+// Notify는 포스트 목록을 Discord 메시지 하나로 보냅니다.
+func (n *DiscordNotifier) Notify(posts []models.BlogPost) error {
+	content, err := renderMessage(n.tmpl, posts)
+	if err != nil {
+		return err
+	}
+
+	// Discord는 2000자를 넘는 메시지를 거부하므로 잘라서 보냄
+	if runes := []rune(content); len(runes) > discordContentLimit {
+		content = string(runes[:discordContentLimit-1]) + "…"
+	}
+
+	return postJSON(n.client, n.url, map[string]any{
+		"content": content,
+	}, n.retry)
+}
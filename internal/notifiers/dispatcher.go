@@ -0,0 +1,135 @@
+package notifiers
+
+import (
+	"errors"
+	"fmt"
+	"log"
+
+	"hello-go/internal/config"
+	"hello-go/internal/models"
+)
+
+// defaultBatchSize는 채널 설정에 batch_size가 없을 때 메시지 하나에 담을 포스트 수입니다.
+const defaultBatchSize = 10
+
+// Channel은 필터와 묶음 크기가 설정된 알림 채널입니다.
+type Channel struct {
+	Notifier   models.Notifier
+	Sources    map[string]bool
+	Categories map[string]bool
+	BatchSize  int
+}
+
+// accepts는 포스트가 채널의 소스/카테고리 필터를 통과하는지 확인합니다.
+func (c Channel) accepts(post models.BlogPost) bool {
+	if len(c.Sources) > 0 && !c.Sources[post.Source] {
+		return false
+	}
+	if len(c.Categories) > 0 && !c.Categories[post.Category] {
+		return false
+	}
+	return true
+}
+
+// Dispatcher는 새 포스트를 여러 알림 채널로 나누어 보냅니다.
+type Dispatcher struct {
+	channels []Channel
+}
+
+// NewDispatcher는 채널 목록으로 Dispatcher 인스턴스를 생성합니다.
+func NewDispatcher(channels ...Channel) *Dispatcher {
+	return &Dispatcher{channels: channels}
+}
+
+// NewDispatcherFromConfig는 설정의 알림 채널로 Dispatcher 인스턴스를 생성합니다.
+func NewDispatcherFromConfig(cfgs []config.NotifierConfig) (*Dispatcher, error) {
+	var channels []Channel
+	for _, cfg := range cfgs {
+		notifier, err := newNotifier(cfg)
+		if err != nil {
+			return nil, err
+		}
+		channels = append(channels, Channel{
+			Notifier:   notifier,
+			Sources:    toSet(cfg.Sources),
+			Categories: toSet(cfg.Categories),
+			BatchSize:  cfg.BatchSize,
+		})
+	}
+	return NewDispatcher(channels...), nil
+}
+
+// newNotifier는 채널 설정의 종류에 맞는 Notifier를 생성합니다.
+func newNotifier(cfg config.NotifierConfig) (models.Notifier, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("알림 채널 %s의 url이 비어 있습니다", cfg.Name)
+	}
+
+	retry := DefaultRetryPolicy
+	if cfg.MaxRetries > 0 {
+		retry.MaxRetries = cfg.MaxRetries
+	}
+
+	name := cfg.Name
+	if name == "" {
+		name = cfg.Type
+	}
+
+	switch cfg.Type {
+	case "slack":
+		return NewSlackNotifier(name, cfg.URL, cfg.Template, retry)
+	case "discord":
+		return NewDiscordNotifier(name, cfg.URL, cfg.Template, retry)
+	case "webhook":
+		return NewWebhookNotifier(name, cfg.URL, cfg.Template, retry)
+	default:
+		return nil, fmt.Errorf("지원하지 않는 알림 종류: %s", cfg.Type)
+	}
+}
+
+// Dispatch는 채널별로 필터를 통과한 포스트를 묶음 단위로 보냅니다.
+// 한 채널이 실패해도 나머지 채널로는 계속 보내며, 발생한 오류를 모아 반환합니다.
+func (d *Dispatcher) Dispatch(posts []models.BlogPost) error {
+	if len(posts) == 0 {
+		return nil
+	}
+
+	var errs []error
+	for _, channel := range d.channels {
+		var matched []models.BlogPost
+		for _, post := range posts {
+			if channel.accepts(post) {
+				matched = append(matched, post)
+			}
+		}
+		if len(matched) == 0 {
+			continue
+		}
+
+		batchSize := channel.BatchSize
+		if batchSize <= 0 {
+			batchSize = defaultBatchSize
+		}
+
+		sent := 0
+		for start := 0; start < len(matched); start += batchSize {
+			batch := matched[start:min(start+batchSize, len(matched))]
+			if err := channel.Notifier.Notify(batch); err != nil {
+				errs = append(errs, fmt.Errorf("%s 알림 실패: %w", channel.Notifier.Name(), err))
+				continue
+			}
+			sent += len(batch)
+		}
+		log.Printf("🔔 %s 알림 전송: %d/%d개 포스트", channel.Notifier.Name(), sent, len(matched))
+	}
+
+	return errors.Join(errs...)
+}
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, v := range values {
+		set[v] = true
+	}
+	return set
+}
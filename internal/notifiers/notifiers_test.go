@@ -0,0 +1,134 @@
+package notifiers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"hello-go/internal/config"
+	"hello-go/internal/models"
+)
+
+// fastRetry는 테스트에서 대기 시간을 줄인 재시도 정책입니다.
+var fastRetry = RetryPolicy{MaxRetries: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+func TestBackoff(t *testing.T) {
+	p := RetryPolicy{BaseDelay: time.Second, MaxDelay: 5 * time.Second}
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second} {
+		if got := p.backoff(attempt); got != want {
+			t.Errorf("backoff(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+}
+
+func TestWebhookRetriesServerErrors(t *testing.T) {
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	n, err := NewWebhookNotifier("test", srv.URL, "", fastRetry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Notify([]models.BlogPost{{Title: "a"}}); err != nil {
+		t.Fatalf("Notify() = %v", err)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("요청 %d회, want 3", got)
+	}
+}
+
+func TestWebhookGivesUp(t *testing.T) {
+	tests := []struct {
+		name   string
+		status int
+		want   int32
+	}{
+		{"클라이언트 오류는 재시도하지 않음", http.StatusBadRequest, 1},
+		{"서버 오류는 최대 횟수까지 재시도", http.StatusInternalServerError, int32(fastRetry.MaxRetries) + 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls atomic.Int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls.Add(1)
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			n, err := NewWebhookNotifier("test", srv.URL, "", fastRetry)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := n.Notify([]models.BlogPost{{Title: "a"}}); err == nil {
+				t.Fatal("Notify() 오류 없음")
+			}
+			if got := calls.Load(); got != tt.want {
+				t.Errorf("요청 %d회, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDispatchFiltersAndBatches(t *testing.T) {
+	var mu sync.Mutex
+	var batches [][]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload struct {
+			Count int               `json:"count"`
+			Posts []models.BlogPost `json:"posts"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		var titles []string
+		for _, post := range payload.Posts {
+			titles = append(titles, post.Title)
+		}
+		mu.Lock()
+		batches = append(batches, titles)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	d, err := NewDispatcherFromConfig([]config.NotifierConfig{{
+		Name:       "toss-dev",
+		Type:       "webhook",
+		URL:        srv.URL,
+		Sources:    []string{"토스"},
+		Categories: []string{"개발"},
+		BatchSize:  2,
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	posts := []models.BlogPost{
+		{Title: "a", Source: "토스", Category: "개발"},
+		{Title: "b", Source: "카카오", Category: "개발"},
+		{Title: "c", Source: "토스", Category: "디자인"},
+		{Title: "d", Source: "토스", Category: "개발"},
+		{Title: "e", Source: "토스", Category: "개발"},
+	}
+	if err := d.Dispatch(posts); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(batches) != 2 || len(batches[0]) != 2 || len(batches[1]) != 1 {
+		t.Fatalf("batches = %v, want [[a d] [e]]", batches)
+	}
+	if batches[0][0] != "a" || batches[0][1] != "d" || batches[1][0] != "e" {
+		t.Errorf("batches = %v, want [[a d] [e]]", batches)
+	}
+}
@@ -0,0 +1,54 @@
+package notifiers
+
+import (
+	"net/http"
+	"text/template"
+	"time"
+
+	"hello-go/internal/models"
+)
+
+const defaultSlackTemplate = `*🆕 새 기술 블로그 포스트 {{.Count}}개*
+{{range .Posts}}• <{{.URL}}|{{slack .Title}}> - {{slack .Source}} · {{slack .Category}} ({{date .PublishedAt}})
+{{end}}`
+
+// SlackNotifier는 Slack Incoming Webhook으로 알림을 보냅니다.
+type SlackNotifier struct {
+	name   string
+	url    string
+	client *http.Client
+	tmpl   *template.Template
+	retry  RetryPolicy
+}
+
+// NewSlackNotifier는 새로운 SlackNotifier 인스턴스를 생성합니다. tmpl이 비어 있으면 기본 템플릿을 사용합니다.
+func NewSlackNotifier(name, url, tmpl string, retry RetryPolicy) (*SlackNotifier, error) {
+	parsed, err := parseTemplate(name, tmpl, defaultSlackTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return &SlackNotifier{
+		name:   name,
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+		tmpl:   parsed,
+		retry:  retry,
+	}, nil
+}
+
+// Name은 알림 채널 이름을 반환합니다.
+func (n *SlackNotifier) Name() string {
+	return n.name
+}
+
+// Notify는 포스트 목록을 Slack 메시지 하나로 보냅니다.
+func (n *SlackNotifier) Notify(posts []models.BlogPost) error {
+	text, err := renderMessage(n.tmpl, posts)
+	if err != nil {
+		return err
+	}
+	return postJSON(n.client, n.url, map[string]any{
+		"text":         text,
+		"unfurl_links": false,
+	}, n.retry)
+}
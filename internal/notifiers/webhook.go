@@ -0,0 +1,141 @@
+package notifiers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"hello-go/internal/models"
+)
+
+// RetryPolicy는 웹훅 전송 실패 시 재시도 정책입니다.
+type RetryPolicy struct {
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// DefaultRetryPolicy는 기본 재시도 정책입니다.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	BaseDelay:  time.Second,
+	MaxDelay:   30 * time.Second,
+}
+
+// backoff는 attempt번째 재시도 전 대기 시간을 계산합니다. (지수 백오프)
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.BaseDelay << attempt
+	if delay <= 0 || delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+// messageData는 메시지 템플릿에 전달되는 데이터입니다.
+type messageData struct {
+	Posts []models.BlogPost
+	Count int
+}
+
+// templateFuncs는 메시지 템플릿에서 사용할 수 있는 함수 목록입니다.
+var templateFuncs = template.FuncMap{
+	// slack은 Slack mrkdwn에서 특별한 의미를 갖는 문자를 이스케이프합니다.
+	"slack": func(s string) string {
+		return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+	},
+	"date": func(t time.Time) string {
+		return t.Format("2006-01-02")
+	},
+}
+
+// parseTemplate은 메시지 템플릿을 파싱합니다. text가 비어 있으면 fallback을 사용합니다.
+func parseTemplate(name, text, fallback string) (*template.Template, error) {
+	if text == "" {
+		text = fallback
+	}
+	tmpl, err := template.New(name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("메시지 템플릿 파싱 실패 (%s): %w", name, err)
+	}
+	return tmpl, nil
+}
+
+// renderMessage는 템플릿으로 포스트 목록의 메시지 본문을 생성합니다.
+func renderMessage(tmpl *template.Template, posts []models.BlogPost) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, messageData{Posts: posts, Count: len(posts)}); err != nil {
+		return "", fmt.Errorf("메시지 생성 실패: %w", err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// postJSON은 payload를 JSON으로 웹훅에 전송하며, 네트워크 오류와 429/5xx 응답은 백오프 후 재시도합니다.
+func postJSON(client *http.Client, url string, payload any, retry RetryPolicy) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("웹훅 payload 인코딩 실패: %w", err)
+	}
+
+	var lastErr error
+	for attempt := 0; attempt <= retry.MaxRetries; attempt++ {
+		if attempt > 0 {
+			delay := retry.backoff(attempt - 1)
+			log.Printf("웹훅 재시도 %d/%d (%v 후): %v", attempt, retry.MaxRetries, delay, lastErr)
+			time.Sleep(delay)
+		}
+
+		var retryAfter time.Duration
+		retryAfter, lastErr = send(client, url, body)
+		if lastErr == nil {
+			return nil
+		}
+		if retryAfter < 0 {
+			// 재시도해도 성공할 수 없는 응답
+			return lastErr
+		}
+		if retryAfter > 0 {
+			time.Sleep(retryAfter)
+		}
+	}
+
+	return fmt.Errorf("웹훅 전송 실패 (%d회 재시도): %w", retry.MaxRetries, lastErr)
+}
+
+// send는 웹훅 요청을 한 번 보냅니다. 재시도할 수 없는 오류면 음수, Retry-After가 있으면 그 시간을 함께 반환합니다.
+func send(client *http.Client, url string, body []byte) (time.Duration, error) {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return -1, fmt.Errorf("웹훅 요청 생성 실패: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("웹훅 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		return 0, nil
+	}
+
+	detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	err = fmt.Errorf("웹훅 응답 오류: %d %s", resp.StatusCode, strings.TrimSpace(string(detail)))
+
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		if seconds, convErr := strconv.Atoi(resp.Header.Get("Retry-After")); convErr == nil && seconds > 0 {
+			return time.Duration(seconds) * time.Second, err
+		}
+		return 0, err
+	}
+	return -1, err
+}
@@ -0,0 +1,56 @@
+package notifiers
+
+import (
+	"net/http"
+	"text/template"
+	"time"
+
+	"hello-go/internal/models"
+)
+
+const defaultWebhookTemplate = `새 기술 블로그 포스트 {{.Count}}개
+{{range .Posts}}- {{.Title}} ({{.Source}}) {{.URL}}
+{{end}}`
+
+// WebhookNotifier는 범용 JSON 웹훅으로 알림을 보냅니다.
+// 요청 본문은 {"text": 메시지, "count": 포스트 수, "posts": 포스트 목록} 형식입니다.
+type WebhookNotifier struct {
+	name   string
+	url    string
+	client *http.Client
+	tmpl   *template.Template
+	retry  RetryPolicy
+}
+
+// NewWebhookNotifier는 새로운 WebhookNotifier 인스턴스를 생성합니다. tmpl이 비어 있으면 기본 템플릿을 사용합니다.
+func NewWebhookNotifier(name, url, tmpl string, retry RetryPolicy) (*WebhookNotifier, error) {
+	parsed, err := parseTemplate(name, tmpl, defaultWebhookTemplate)
+	if err != nil {
+		return nil, err
+	}
+	return &WebhookNotifier{
+		name:   name,
+		url:    url,
+		client: &http.Client{Timeout: 10 * time.Second},
+		tmpl:   parsed,
+		retry:  retry,
+	}, nil
+}
+
+// Name은 알림 채널 이름을 반환합니다.
+func (n *WebhookNotifier) Name() string {
+	return n.name
+}
+
+// Notify는 포스트 목록을 JSON 요청 하나로 보냅니다.
+func (n *WebhookNotifier) Notify(posts []models.BlogPost) error {
+	text, err := renderMessage(n.tmpl, posts)
+	if err != nil {
+		return err
+	}
+	return postJSON(n.client, n.url, map[string]any{
+		"text":  text,
+		"count": len(posts),
+		"posts": posts,
+	}, n.retry)
+}
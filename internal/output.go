@@ -0,0 +1,29 @@
+package internal
+
+import (
+	"fmt"
+
+	"hello-go/internal/snapshot"
+)
+
+// OutputFiles는 출력 형식별 기본 파일 이름입니다.
+var OutputFiles = map[string]string{
+	"html": "index.html",
+	"json": "posts.json",
+}
+
+// Render는 스냅샷을 지정된 형식(html, json)으로 렌더링합니다.
+func Render(format string, snap snapshot.Snapshot) ([]byte, error) {
+	switch format {
+	case "html":
+		html, err := GenerateHTML(snap.Posts, BlogStats(snap.Posts))
+		if err != nil {
+			return nil, fmt.Errorf("HTML 생성 실패: %w", err)
+		}
+		return []byte(html), nil
+	case "json":
+		return snap.Marshal()
+	default:
+		return nil, fmt.Errorf("지원하지 않는 형식: %s", format)
+	}
+}
@@ -0,0 +1,38 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"hello-go/internal/models"
+	"hello-go/internal/snapshot"
+)
+
+func TestRenderHTMLLeavesSnapshotUntouched(t *testing.T) {
+	snap := snapshot.Snapshot{Posts: []models.BlogPost{
+		{Title: "old", URL: "https://a/1", PublishedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{Title: "new", URL: "https://a/2", PublishedAt: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)},
+	}}
+
+	if _, err := Render("html", snap); err != nil {
+		t.Fatal(err)
+	}
+	data, err := Render("json", snap)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got snapshot.Snapshot
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Posts[0].Title != "old" {
+		t.Errorf("JSON 출력 순서가 바뀜: %q가 처음", got.Posts[0].Title)
+	}
+	for _, post := range got.Posts {
+		if post.Image != "" || post.ImageColor != "" {
+			t.Errorf("JSON 출력에 HTML 기본 이미지가 들어감: %q", post.Title)
+		}
+	}
+}
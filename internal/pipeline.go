@@ -0,0 +1,254 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"hello-go/internal/httpcache"
+	"hello-go/internal/models"
+	"hello-go/internal/scheduler"
+)
+
+// defaultBuffer는 파이프라인 단계 사이 채널의 기본 크기입니다.
+const defaultBuffer = 64
+
+// enrichWorkers는 상세 페이지 보강 단계의 고루틴 수입니다. 실제 동시 요청 수는 Scheduler가 제한합니다.
+const enrichWorkers = 8
+
+// Stage는 파이프라인의 사용자 단계입니다. Apply가 false를 반환하면 포스트를 버립니다.
+type Stage struct {
+	Name string
+	// Workers는 Apply를 동시에 실행하는 고루틴 수이며, 1보다 작으면 1입니다. 2 이상이면 포스트 순서가 바뀔 수 있습니다.
+	Workers int
+	Apply   func(post models.BlogPost) (models.BlogPost, bool)
+}
+
+// Pipeline은 크롤러가 내보내는 포스트를 정규화, 날짜 필터링, 중복 제거, 보강(Options.Enrich), 사용자 단계(Stages), Sink 순으로 흘려보냅니다.
+// 각 단계는 별도 고루틴에서 실행되고 Buffer 크기의 채널로 연결되므로, 뒤 단계가 느리면 앞 단계와 크롤러가 기다립니다.
+type Pipeline struct {
+	Options Options
+	// Buffer는 단계 사이 채널 크기입니다. 0이면 defaultBuffer를 사용합니다.
+	Buffer int
+	Stages []Stage
+	// Sink는 모든 단계를 통과한 포스트를 하나씩 받습니다. 한 고루틴에서만 호출되며, 오류를 반환하면 파이프라인을 중단합니다.
+	Sink func(post models.BlogPost) error
+}
+
+// Stream은 크롤러의 포스트를 하나씩 내보냅니다.
+// StreamingCrawler가 아니면 Crawl이 끝난 뒤 결과를 내보내며, Crawl이 실패하면 함께 반환한 포스트를 먼저 내보낸 뒤 오류를 내보냅니다.
+func Stream(c models.BlogCrawler) iter.Seq2[models.BlogPost, error] {
+	if s, ok := c.(models.StreamingCrawler); ok {
+		return s.Stream()
+	}
+	return func(yield func(models.BlogPost, error) bool) {
+		posts, err := c.Crawl()
+		for _, post := range posts {
+			if !yield(post, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield(models.BlogPost{}, err)
+		}
+	}
+}
+
+// Run은 크롤러들을 병렬로 실행하여 파이프라인을 끝까지 흘려보내고 실행 정보를 반환합니다.
+// 개별 크롤러의 실패는 오류로 반환하지 않고 실행 정보의 소스별 Error에 기록합니다.
+// 스트리밍 크롤러가 중간에 실패하면 그 전에 내보낸 포스트는 그대로 처리됩니다.
+func (p Pipeline) Run(ctx context.Context, blogCrawlers ...models.BlogCrawler) (run models.CrawlRun, err error) {
+	run = models.CrawlRun{
+		StartedAt:  time.Now(),
+		FilterDate: p.Options.FilterDate,
+	}
+	defer func() { run.FinishedAt = time.Now() }()
+
+	// 크롤링 전에 필터 날짜를 검증
+	since, err := time.Parse("2006-01-02", p.Options.FilterDate)
+	if err != nil {
+		return run, fmt.Errorf("필터 날짜 파싱 실패: %w", err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	window := scheduler.Default().Measure()
+	defer func() {
+		stats := window.Stop()
+		run.Scheduler = &stats
+		log.Printf("🚦 외부 요청 %d개 (최대 동시 %d/%d, 사용률 %.0f%%, 평균 대기 %dms, 최대 대기 %dms)",
+			stats.Requests, stats.PeakInFlight, stats.MaxInFlight, stats.Utilisation*100, stats.AvgQueueMs, stats.MaxQueueMs)
+	}()
+
+	if cache := httpcache.Default(); cache != nil {
+		cacheWindow := cache.Measure()
+		defer func() {
+			run.Cache = cacheWindow.Stop()
+			for _, stats := range run.Cache {
+				log.Printf("🗄️  %s HTTP 캐시 적중률: %.0f%% (%d/%d)", stats.Source, stats.HitRatio*100, stats.Hits, stats.Requests)
+			}
+		}()
+	}
+
+	buffer := p.Buffer
+	if buffer <= 0 {
+		buffer = defaultBuffer
+	}
+
+	// 크롤러마다 고루틴 하나가 같은 채널로 포스트를 내보냄
+	sources := make([]models.SourceRun, len(blogCrawlers))
+	raw := make(chan models.BlogPost, buffer)
+	var wg sync.WaitGroup
+	for i, crawler := range blogCrawlers {
+		wg.Add(1)
+		go func(i int, c models.BlogCrawler) {
+			defer wg.Done()
+			sources[i] = produce(ctx, c, raw)
+		}(i, crawler)
+	}
+	go func() {
+		wg.Wait()
+		close(raw)
+	}()
+
+	// 각 카운터는 한 단계의 고루틴에서만 증가하며, 마지막 채널이 닫힌 뒤에 읽음
+	var total, filtered, unique, duplicates int
+	out := runStage(ctx, raw, buffer, 1, func(post models.BlogPost) (models.BlogPost, bool) {
+		total++
+		post.Title = strings.TrimSpace(post.Title)
+		post.URL = strings.TrimSpace(post.URL)
+		return post, true
+	})
+	out = runStage(ctx, out, buffer, 1, func(post models.BlogPost) (models.BlogPost, bool) {
+		if !inWindow(post, since, p.Options.Until) {
+			return post, false
+		}
+		filtered++
+		return post, true
+	})
+	seenTitles := make(map[string]bool)
+	out = runStage(ctx, out, buffer, 1, func(post models.BlogPost) (models.BlogPost, bool) {
+		if seenTitles[post.Title] {
+			duplicates++
+			log.Printf("중복 제거: %s", post.Title)
+			return post, false
+		}
+		seenTitles[post.Title] = true
+		unique++
+		return post, true
+	})
+	var outdated int
+	if enrich := p.Options.Enrich; enrich != nil {
+		var outdatedMu sync.Mutex
+		out = runStage(ctx, out, buffer, enrichWorkers, func(post models.BlogPost) (models.BlogPost, bool) {
+			post = enrich(post)
+			if !inWindow(post, since, p.Options.Until) {
+				outdatedMu.Lock()
+				outdated++
+				outdatedMu.Unlock()
+				log.Printf("보강 후 기간 밖 포스트 제외: %s (%s)", post.Title, post.PublishedAt.Format("2006-01-02"))
+				return post, false
+			}
+			return post, true
+		})
+	}
+	for _, stage := range p.Stages {
+		out = runStage(ctx, out, buffer, stage.Workers, stage.Apply)
+	}
+
+	var sinkErr error
+	for post := range out {
+		if sinkErr != nil || p.Sink == nil {
+			continue
+		}
+		if err := p.Sink(post); err != nil {
+			sinkErr = fmt.Errorf("포스트 저장 실패: %w", err)
+			cancel()
+		}
+	}
+
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].Name < sources[j].Name
+	})
+	run.Sources = sources
+	run.TotalCount = total
+	run.FilteredCount = filtered
+	run.UniqueCount = unique - outdated
+	log.Printf("중복 제거 완료: %d개 중복 제거됨 (필터링 후: %d개 -> 중복 제거 후: %d개)",
+		duplicates, filtered, unique)
+
+	if sinkErr != nil {
+		return run, sinkErr
+	}
+	return run, context.Cause(ctx)
+}
+
+// produce는 크롤러의 포스트를 out으로 보내고 소스별 실행 정보를 반환합니다. ctx가 취소되면 크롤러를 중단합니다.
+func produce(ctx context.Context, c models.BlogCrawler, out chan<- models.BlogPost) models.SourceRun {
+	source := c.GetSource()
+	sourceRun := models.SourceRun{Name: source.Name, URL: source.URL}
+	log.Printf("📡 %s 블로그 크롤링 시작...", source.Name)
+
+	for post, err := range Stream(c) {
+		if err != nil {
+			log.Printf("%s 크롤링 실패: %v", source.Name, err)
+			sourceRun.Error = err.Error()
+			return sourceRun
+		}
+		select {
+		case out <- post:
+			sourceRun.Count++
+		case <-ctx.Done():
+			sourceRun.Error = ctx.Err().Error()
+			return sourceRun
+		}
+	}
+
+	log.Printf("✅ %s 크롤링 완료: %d개 포스트", source.Name, sourceRun.Count)
+	return sourceRun
+}
+
+// runStage는 in의 포스트에 apply를 workers개의 고루틴으로 적용하여 out으로 보냅니다. in이 닫히면 out을 닫습니다.
+// ctx가 취소되면 apply를 호출하지 않고 in을 비우기만 하여 앞 단계가 막히지 않게 합니다.
+func runStage(ctx context.Context, in <-chan models.BlogPost, buffer, workers int, apply func(models.BlogPost) (models.BlogPost, bool)) <-chan models.BlogPost {
+	out := make(chan models.BlogPost, buffer)
+	var wg sync.WaitGroup
+	for range max(workers, 1) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for post := range in {
+				if ctx.Err() != nil {
+					continue
+				}
+				post, ok := apply(post)
+				if !ok {
+					continue
+				}
+				select {
+				case out <- post:
+				case <-ctx.Done():
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// inWindow는 포스트가 since 이후, until(설정된 경우) 이전에 발행되었는지 확인합니다.
+func inWindow(post models.BlogPost, since, until time.Time) bool {
+	if post.PublishedAt.Before(since) {
+		return false
+	}
+	return until.IsZero() || !post.PublishedAt.After(until)
+}
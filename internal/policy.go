@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"hello-go/internal/models"
+)
+
+// ErrEmptySite는 게시할 포스트가 하나도 없을 때 반환됩니다.
+var ErrEmptySite = errors.New("게시할 포스트가 없습니다")
+
+// Policy는 크롤링 결과를 게시해도 되는지 판단하는 기준입니다.
+// 기본값(zero value)은 빈 사이트만 막습니다.
+type Policy struct {
+	// FailOnSourceError이면 소스 하나라도 실패했을 때 게시하지 않습니다.
+	FailOnSourceError bool `json:"fail_on_source_error"`
+	// MinPosts보다 포스트가 적으면 게시하지 않습니다.
+	MinPosts int `json:"min_posts"`
+	// AllowEmpty이면 포스트가 없어도 게시합니다.
+	AllowEmpty bool `json:"allow_empty"`
+	// MinSourceRatio가 0보다 크면 소스의 포스트 수가 직전 실행의 이 비율 미만으로 줄었을 때 OnSourceDrop에 따라 처리합니다.
+	MinSourceRatio float64 `json:"min_source_ratio"`
+	// OnSourceDrop은 포스트 수가 급감한 소스의 처리 방법입니다. (block, carry)
+	OnSourceDrop string `json:"on_source_drop"`
+}
+
+// PolicyError는 정책 위반 내역을 담는 오류입니다.
+type PolicyError struct {
+	Violations []string
+}
+
+func (e *PolicyError) Error() string {
+	return "게시 정책 위반: " + strings.Join(e.Violations, "; ")
+}
+
+// Unwrap은 빈 사이트 위반이면 ErrEmptySite를 반환하여 errors.Is로 확인할 수 있게 합니다.
+func (e *PolicyError) Unwrap() error {
+	for _, v := range e.Violations {
+		if v == ErrEmptySite.Error() {
+			return ErrEmptySite
+		}
+	}
+	return nil
+}
+
+// Check는 크롤링 결과가 정책을 만족하는지 확인하고, 위반하면 *PolicyError를 반환합니다.
+func (p Policy) Check(posts []models.BlogPost, run models.CrawlRun) error {
+	var violations []string
+
+	if p.FailOnSourceError {
+		for _, source := range run.Sources {
+			if source.Error != "" {
+				violations = append(violations, fmt.Sprintf("%s 크롤링 실패: %s", source.Name, source.Error))
+			}
+		}
+	}
+	if len(posts) == 0 && !p.AllowEmpty {
+		violations = append(violations, ErrEmptySite.Error())
+	} else if len(posts) < p.MinPosts {
+		violations = append(violations, fmt.Sprintf("포스트 수 %d개가 최소 %d개보다 적습니다", len(posts), p.MinPosts))
+	}
+
+	if len(violations) > 0 {
+		return &PolicyError{Violations: violations}
+	}
+	return nil
+}
@@ -0,0 +1,281 @@
+// Package scheduler는 프로세스 전체의 외부 요청 동시성을 제한합니다.
+// 모든 크롤러의 HTTP 클라이언트가 같은 Scheduler의 Transport를 사용하여, 전체 동시 요청 수와 호스트별 동시 요청 수가 설정값을 넘지 않습니다.
+package scheduler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"hello-go/internal/models"
+)
+
+// 기본 제한값입니다.
+const (
+	DefaultMaxInFlight = 16
+	DefaultPerHost     = 4
+)
+
+// Limits는 동시 요청 제한 설정입니다.
+type Limits struct {
+	// MaxInFlight는 프로세스 전체에서 동시에 진행 중인 요청의 최대 수입니다.
+	MaxInFlight int
+	// PerHost는 호스트 하나에 동시에 진행 중인 요청의 최대 수입니다.
+	PerHost int
+}
+
+// Scheduler는 전체 및 호스트별 슬롯을 나누어 주고 사용량을 측정합니다.
+type Scheduler struct {
+	limits Limits
+	global chan struct{}
+
+	mu       sync.Mutex
+	hosts    map[string]chan struct{}
+	inFlight int
+	hostLoad map[string]int
+	changed  time.Time
+	windows  map[*Window]bool
+}
+
+// New는 제한값으로 새로운 Scheduler를 생성합니다. 0 이하인 값은 기본값을 사용합니다.
+func New(limits Limits) *Scheduler {
+	if limits.MaxInFlight <= 0 {
+		limits.MaxInFlight = DefaultMaxInFlight
+	}
+	if limits.PerHost <= 0 {
+		limits.PerHost = DefaultPerHost
+	}
+	return &Scheduler{
+		limits:   limits,
+		global:   make(chan struct{}, limits.MaxInFlight),
+		hosts:    make(map[string]chan struct{}),
+		hostLoad: make(map[string]int),
+		changed:  time.Now(),
+		windows:  make(map[*Window]bool),
+	}
+}
+
+var (
+	defaultMu        sync.RWMutex
+	defaultScheduler = New(Limits{})
+)
+
+// Default는 크롤러가 사용하는 프로세스 전체 Scheduler를 반환합니다.
+func Default() *Scheduler {
+	defaultMu.RLock()
+	defer defaultMu.RUnlock()
+	return defaultScheduler
+}
+
+// Configure는 프로세스 전체 Scheduler를 새 제한값으로 교체합니다. 크롤러를 생성하기 전에 호출해야 합니다.
+func Configure(limits Limits) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultScheduler = New(limits)
+}
+
+// Limits는 제한값을 반환합니다.
+func (s *Scheduler) Limits() Limits {
+	return s.limits
+}
+
+// Acquire는 호스트 슬롯과 전체 슬롯을 차례로 얻을 때까지 기다리고, 슬롯을 반환하는 함수를 반환합니다.
+// 호스트 슬롯을 먼저 얻으므로 한 호스트에 몰린 요청이 전체 슬롯을 차지한 채 기다리지 않습니다.
+func (s *Scheduler) Acquire(ctx context.Context, host string) (func(), error) {
+	start := time.Now()
+
+	hostSlots := s.hostSlots(host)
+	select {
+	case hostSlots <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	select {
+	case s.global <- struct{}{}:
+	case <-ctx.Done():
+		<-hostSlots
+		return nil, ctx.Err()
+	}
+
+	s.started(host, time.Since(start))
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.finished(host)
+			<-s.global
+			<-hostSlots
+		})
+	}, nil
+}
+
+// hostSlots는 호스트의 슬롯 채널을 반환합니다.
+func (s *Scheduler) hostSlots(host string) chan struct{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	slots, ok := s.hosts[host]
+	if !ok {
+		slots = make(chan struct{}, s.limits.PerHost)
+		s.hosts[host] = slots
+	}
+	return slots
+}
+
+// started는 슬롯을 얻은 요청을 측정 중인 구간에 기록합니다.
+func (s *Scheduler) started(host string, queued time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance()
+	s.inFlight++
+	s.hostLoad[host]++
+	for w := range s.windows {
+		w.record(host, queued, s.inFlight, s.hostLoad[host])
+	}
+}
+
+// finished는 슬롯을 반환한 요청을 기록합니다.
+func (s *Scheduler) finished(host string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance()
+	s.inFlight--
+	s.hostLoad[host]--
+}
+
+// advance는 마지막 변경 이후 진행 중이던 요청 수를 측정 중인 구간의 사용량에 더합니다. mu를 잡고 호출해야 합니다.
+func (s *Scheduler) advance() {
+	now := time.Now()
+	elapsed := now.Sub(s.changed)
+	s.changed = now
+	if s.inFlight == 0 {
+		return
+	}
+	for w := range s.windows {
+		w.busy += time.Duration(s.inFlight) * elapsed
+	}
+}
+
+// Transport는 base(nil이면 http.DefaultTransport)로 보내는 요청이 슬롯을 얻은 뒤 나가도록 감쌉니다.
+// 슬롯은 응답 본문을 닫을 때 반환됩니다.
+func (s *Scheduler) Transport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	return &transport{scheduler: s, base: base}
+}
+
+type transport struct {
+	scheduler *Scheduler
+	base      http.RoundTripper
+}
+
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	release, err := t.scheduler.Acquire(req.Context(), req.URL.Host)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	resp.Body = &releaseBody{ReadCloser: resp.Body, release: release}
+	return resp, nil
+}
+
+// releaseBody는 닫힐 때 슬롯을 반환하는 응답 본문입니다.
+type releaseBody struct {
+	io.ReadCloser
+	release func()
+}
+
+func (b *releaseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.release()
+	return err
+}
+
+// Window는 Measure부터 Stop까지의 요청 사용량을 측정합니다.
+type Window struct {
+	scheduler *Scheduler
+	start     time.Time
+	requests  int
+	queued    time.Duration
+	maxQueued time.Duration
+	peak      int
+	busy      time.Duration
+	hosts     map[string]*hostWindow
+}
+
+type hostWindow struct {
+	requests int
+	queued   time.Duration
+	peak     int
+}
+
+// Measure는 사용량 측정을 시작합니다. 여러 구간을 동시에 측정할 수 있습니다.
+func (s *Scheduler) Measure() *Window {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance()
+	w := &Window{scheduler: s, start: time.Now(), hosts: make(map[string]*hostWindow)}
+	s.windows[w] = true
+	return w
+}
+
+func (w *Window) record(host string, queued time.Duration, inFlight, hostLoad int) {
+	w.requests++
+	w.queued += queued
+	w.maxQueued = max(w.maxQueued, queued)
+	w.peak = max(w.peak, inFlight)
+
+	h, ok := w.hosts[host]
+	if !ok {
+		h = &hostWindow{}
+		w.hosts[host] = h
+	}
+	h.requests++
+	h.queued += queued
+	h.peak = max(h.peak, hostLoad)
+}
+
+// Stop은 측정을 끝내고 구간의 사용량을 반환합니다.
+// Utilisation은 구간 동안 평균 동시 요청 수를 MaxInFlight로 나눈 값입니다.
+func (w *Window) Stop() models.SchedulerStats {
+	s := w.scheduler
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.advance()
+	delete(s.windows, w)
+
+	elapsed := time.Since(w.start)
+	stats := models.SchedulerStats{
+		MaxInFlight:  s.limits.MaxInFlight,
+		PerHost:      s.limits.PerHost,
+		Requests:     w.requests,
+		PeakInFlight: w.peak,
+		MaxQueueMs:   w.maxQueued.Milliseconds(),
+	}
+	if elapsed > 0 {
+		stats.Utilisation = float64(w.busy) / float64(elapsed) / float64(s.limits.MaxInFlight)
+	}
+	if w.requests > 0 {
+		stats.AvgQueueMs = w.queued.Milliseconds() / int64(w.requests)
+	}
+
+	for host, h := range w.hosts {
+		stats.Hosts = append(stats.Hosts, models.HostStats{
+			Host:         host,
+			Requests:     h.requests,
+			PeakInFlight: h.peak,
+			AvgQueueMs:   h.queued.Milliseconds() / int64(h.requests),
+		})
+	}
+	sort.Slice(stats.Hosts, func(i, j int) bool {
+		return stats.Hosts[i].Host < stats.Hosts[j].Host
+	})
+	return stats
+}
@@ -0,0 +1,179 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// tryAcquire는 슬롯을 바로 얻을 수 있는지 확인하고, 얻었으면 반환합니다.
+func tryAcquire(s *Scheduler, host string) bool {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	release, err := s.Acquire(ctx, host)
+	if err != nil {
+		return false
+	}
+	release()
+	return true
+}
+
+func TestAcquireRespectsContext(t *testing.T) {
+	s := New(Limits{MaxInFlight: 1, PerHost: 1})
+	release, err := s.Acquire(context.Background(), "a.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := s.Acquire(ctx, "a.example.com"); !errors.Is(err, context.Canceled) {
+		t.Errorf("호스트 슬롯 대기: err = %v, want Canceled", err)
+	}
+	// 다른 호스트도 전체 슬롯이 없으면 기다리며, 취소되면 얻은 호스트 슬롯을 돌려줌
+	if _, err := s.Acquire(ctx, "b.example.com"); !errors.Is(err, context.Canceled) {
+		t.Errorf("전체 슬롯 대기: err = %v, want Canceled", err)
+	}
+
+	release()
+	release() // 두 번 호출해도 한 번만 반환
+	if !tryAcquire(s, "b.example.com") || !tryAcquire(s, "a.example.com") {
+		t.Error("반환한 슬롯을 다시 얻지 못함")
+	}
+}
+
+func TestTransportReleasesOnBodyClose(t *testing.T) {
+	for _, status := range []int{http.StatusOK, http.StatusNotFound, http.StatusInternalServerError} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+			io.WriteString(w, "본문")
+		}))
+
+		s := New(Limits{MaxInFlight: 1, PerHost: 1})
+		client := &http.Client{Transport: s.Transport(nil)}
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.StatusCode != status {
+			t.Errorf("status = %d, want %d", resp.StatusCode, status)
+		}
+
+		// 본문을 닫기 전까지는 슬롯을 차지
+		if tryAcquire(s, "other.example.com") {
+			t.Errorf("%d: 본문을 닫기 전에 슬롯이 반환됨", status)
+		}
+		resp.Body.Close()
+		resp.Body.Close()
+		if !tryAcquire(s, "other.example.com") {
+			t.Errorf("%d: 본문을 닫은 뒤 슬롯이 반환되지 않음", status)
+		}
+		server.Close()
+	}
+}
+
+type failingTransport struct{}
+
+func (failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("연결 실패")
+}
+
+func TestTransportReleasesOnError(t *testing.T) {
+	s := New(Limits{MaxInFlight: 1, PerHost: 1})
+	client := &http.Client{Transport: s.Transport(failingTransport{})}
+
+	for range 3 {
+		if _, err := client.Get("http://a.example.com/"); err == nil {
+			t.Fatal("오류 없음")
+		}
+	}
+	if !tryAcquire(s, "a.example.com") {
+		t.Error("요청 오류 후 슬롯이 반환되지 않음")
+	}
+}
+
+// concurrencyServer는 동시에 처리 중인 요청 수의 최댓값을 기록합니다.
+type concurrencyServer struct {
+	current, peak *atomic.Int32
+	total         *atomic.Int32
+	hostCurrent   atomic.Int32
+	hostPeak      atomic.Int32
+}
+
+func (c *concurrencyServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	updatePeak(c.current.Add(1), c.peak)
+	updatePeak(c.hostCurrent.Add(1), &c.hostPeak)
+	time.Sleep(5 * time.Millisecond)
+	c.hostCurrent.Add(-1)
+	c.current.Add(-1)
+	c.total.Add(1)
+}
+
+func updatePeak(n int32, peak *atomic.Int32) {
+	for {
+		p := peak.Load()
+		if n <= p || peak.CompareAndSwap(p, n) {
+			return
+		}
+	}
+}
+
+func TestLimitsHoldUnderLoad(t *testing.T) {
+	limits := Limits{MaxInFlight: 5, PerHost: 3}
+	s := New(limits)
+	client := &http.Client{Transport: s.Transport(nil)}
+
+	var current, peak, total atomic.Int32
+	servers := make([]*concurrencyServer, 3)
+	urls := make([]string, len(servers))
+	for i := range servers {
+		servers[i] = &concurrencyServer{current: &current, peak: &peak, total: &total}
+		server := httptest.NewServer(servers[i])
+		defer server.Close()
+		urls[i] = server.URL
+	}
+
+	window := s.Measure()
+	var wg sync.WaitGroup
+	for i := range 60 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(urls[i%len(urls)])
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+	stats := window.Stop()
+
+	if total.Load() != 60 || stats.Requests != 60 {
+		t.Errorf("처리 %d개, 기록 %d개, want 60", total.Load(), stats.Requests)
+	}
+	if p := peak.Load(); p > int32(limits.MaxInFlight) {
+		t.Errorf("전체 동시 요청 %d개, 제한 %d", p, limits.MaxInFlight)
+	}
+	for i, server := range servers {
+		if p := server.hostPeak.Load(); p > int32(limits.PerHost) {
+			t.Errorf("서버 %d 동시 요청 %d개, 제한 %d", i, p, limits.PerHost)
+		}
+	}
+	if stats.PeakInFlight > limits.MaxInFlight || stats.MaxInFlight != limits.MaxInFlight || len(stats.Hosts) != 3 {
+		t.Errorf("stats = %+v", stats)
+	}
+	for _, host := range stats.Hosts {
+		if host.PeakInFlight > limits.PerHost || host.Requests != 20 {
+			t.Errorf("host = %+v", host)
+		}
+	}
+}
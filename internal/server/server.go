@@ -0,0 +1,155 @@
+package server
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"hello-go/internal/store"
+)
+
+// Server는 생성된 사이트와 포스트 조회 API를 제공하는 HTTP 서버입니다.
+type Server struct {
+	store *store.PostStore
+	mu    sync.RWMutex
+	html  []byte
+	mux   *http.ServeMux
+}
+
+// NewServer는 새로운 Server 인스턴스를 생성합니다.
+func NewServer(postStore *store.PostStore) *Server {
+	s := &Server{
+		store: postStore,
+		mux:   http.NewServeMux(),
+	}
+
+	s.mux.HandleFunc("GET /{$}", s.handleIndex)
+	s.mux.HandleFunc("GET /index.html", s.handleIndex)
+	s.mux.HandleFunc("GET /api/posts", s.handlePosts)
+	s.mux.HandleFunc("GET /api/sources", s.handleSources)
+	s.mux.HandleFunc("GET /api/runs/latest", s.handleLatestRun)
+
+	return s
+}
+
+// SetHTML은 제공할 사이트 HTML을 교체합니다.
+func (s *Server) SetHTML(html string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.html = []byte(html)
+}
+
+// ServeHTTP는 http.Handler 인터페이스를 구현합니다.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+	s.mux.ServeHTTP(w, r)
+	log.Printf("🌐 %s %s (%v)", r.Method, r.URL.RequestURI(), time.Since(start))
+}
+
+func (s *Server) handleIndex(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	html := s.html
+	s.mu.RUnlock()
+
+	if html == nil {
+		http.Error(w, "아직 생성된 사이트가 없습니다.", http.StatusServiceUnavailable)
+		return
+	}
+
+	writeWithETag(w, r, "text/html; charset=utf-8", html)
+}
+
+func (s *Server) handlePosts(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	query := store.PostQuery{
+		Source:   params.Get("source"),
+		Category: params.Get("category"),
+		Q:        params.Get("q"),
+	}
+
+	if since := params.Get("since"); since != "" {
+		t, err := time.Parse("2006-01-02", since)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "since는 YYYY-MM-DD 형식이어야 합니다.")
+			return
+		}
+		query.Since = t
+	}
+
+	if page := params.Get("page"); page != "" {
+		n, err := strconv.Atoi(page)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, "page는 1 이상의 정수여야 합니다.")
+			return
+		}
+		query.Page = n
+	}
+
+	writeJSON(w, r, s.store.Query(query))
+}
+
+func (s *Server) handleSources(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, r, s.store.Sources())
+}
+
+func (s *Server) handleLatestRun(w http.ResponseWriter, r *http.Request) {
+	run, ok := s.store.LatestRun()
+	if !ok {
+		writeError(w, http.StatusNotFound, "아직 실행된 크롤링이 없습니다.")
+		return
+	}
+	writeJSON(w, r, run)
+}
+
+// writeJSON은 값을 JSON으로 인코딩하여 ETag와 함께 응답합니다.
+func writeJSON(w http.ResponseWriter, r *http.Request, v any) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(v); err != nil {
+		writeError(w, http.StatusInternalServerError, "응답 인코딩 실패")
+		return
+	}
+	writeWithETag(w, r, "application/json; charset=utf-8", buf.Bytes())
+}
+
+// writeWithETag는 본문 해시로 ETag를 설정하고, If-None-Match가 일치하면 304를 응답합니다.
+func writeWithETag(w http.ResponseWriter, r *http.Request, contentType string, body []byte) {
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:])[:16] + `"`
+
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+
+	if matchesETag(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentType)
+	if _, err := w.Write(body); err != nil {
+		log.Printf("응답 쓰기 실패: %v", err)
+	}
+}
+
+// matchesETag는 If-None-Match 헤더에 ETag가 포함되어 있는지 확인합니다.
+func matchesETag(header, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == etag || candidate == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"hello-go/internal/models"
+	"hello-go/internal/store"
+)
+
+// newTestServer는 토스 포스트 25개와 카카오 포스트 2개가 담긴 서버를 생성합니다.
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	var posts []models.BlogPost
+	for i := range 25 {
+		posts = append(posts, models.BlogPost{
+			Title:       fmt.Sprintf("토스 포스트 %d", i),
+			URL:         fmt.Sprintf("https://toss.tech/article/%d", i),
+			Source:      "토스",
+			Category:    "Frontend",
+			PublishedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, i),
+		})
+	}
+	posts = append(posts,
+		models.BlogPost{Title: "Kafka 운영기", Summary: "메시지 큐", URL: "https://tech.kakao.com/posts/1", Source: "카카오", Category: "Backend", PublishedAt: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		models.BlogPost{Title: "추천 모델", Summary: "kafka 스트림으로 만든 추천", URL: "https://tech.kakao.com/posts/2", Source: "카카오", Category: "AI", PublishedAt: time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)},
+	)
+
+	postStore := store.NewPostStore()
+	postStore.Replace(posts, models.CrawlRun{
+		FilterDate: "2024-01-01",
+		Sources: []models.SourceRun{
+			{Name: "토스", URL: "https://toss.tech", Count: 25},
+			{Name: "카카오", URL: "https://tech.kakao.com", Count: 2},
+			{Name: "네이버 D2", URL: "https://d2.naver.com", Error: "피드 요청 실패"},
+		},
+	})
+	return NewServer(postStore)
+}
+
+func get(t *testing.T, s *Server, target string, header http.Header) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, target, nil)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	rec := httptest.NewRecorder()
+	s.ServeHTTP(rec, req)
+	return rec
+}
+
+func decode[T any](t *testing.T, rec *httptest.ResponseRecorder) T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal(rec.Body.Bytes(), &v); err != nil {
+		t.Fatalf("응답 파싱 실패: %v (%s)", err, rec.Body.String())
+	}
+	return v
+}
+
+func TestPostsFiltersAndPages(t *testing.T) {
+	s := newTestServer(t)
+
+	tests := []struct {
+		target     string
+		wantTotal  int
+		wantPosts  int
+		wantFirst  string
+		wantPages  int
+		wantPageNo int
+	}{
+		{"/api/posts", 27, store.DefaultPageSize, "Kafka 운영기", 2, 1},
+		{"/api/posts?page=2", 27, 7, "토스 포스트 5", 2, 2},
+		{"/api/posts?page=9", 27, 0, "", 2, 9},
+		{"/api/posts?source=카카오", 2, 2, "Kafka 운영기", 1, 1},
+		{"/api/posts?category=AI", 1, 1, "추천 모델", 1, 1},
+		{"/api/posts?since=2025-01-20", 7, 7, "Kafka 운영기", 1, 1},
+		// 제목과 요약을 대소문자 구분 없이 검색
+		{"/api/posts?q=KAFKA", 2, 2, "Kafka 운영기", 1, 1},
+		{"/api/posts?source=토스&since=2025-01-24&q=포스트", 2, 2, "토스 포스트 24", 1, 1},
+	}
+	for _, tt := range tests {
+		rec := get(t, s, tt.target, nil)
+		if rec.Code != http.StatusOK {
+			t.Errorf("%s: status = %d", tt.target, rec.Code)
+			continue
+		}
+		page := decode[store.PostPage](t, rec)
+		if page.Total != tt.wantTotal || len(page.Posts) != tt.wantPosts || page.TotalPages != tt.wantPages || page.Page != tt.wantPageNo {
+			t.Errorf("%s: total=%d posts=%d pages=%d page=%d, want %d %d %d %d", tt.target,
+				page.Total, len(page.Posts), page.TotalPages, page.Page, tt.wantTotal, tt.wantPosts, tt.wantPages, tt.wantPageNo)
+			continue
+		}
+		if tt.wantFirst != "" && page.Posts[0].Title != tt.wantFirst {
+			t.Errorf("%s: 첫 포스트 = %q, want %q", tt.target, page.Posts[0].Title, tt.wantFirst)
+		}
+	}
+}
+
+func TestPostsRejectsInvalidParams(t *testing.T) {
+	s := newTestServer(t)
+	for _, target := range []string{"/api/posts?since=2025/01/01", "/api/posts?page=0", "/api/posts?page=abc"} {
+		rec := get(t, s, target, nil)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want 400", target, rec.Code)
+		}
+		if body := decode[map[string]string](t, rec); body["error"] == "" {
+			t.Errorf("%s: 오류 메시지 없음", target)
+		}
+	}
+}
+
+func TestSources(t *testing.T) {
+	rec := get(t, newTestServer(t), "/api/sources", nil)
+	sources := decode[[]store.SourceSummary](t, rec)
+
+	if len(sources) != 3 {
+		t.Fatalf("소스 %d개, want 3: %+v", len(sources), sources)
+	}
+	// 이름순이며, 포스트가 없는 소스도 실행 정보에서 포함
+	naver, kakao, toss := sources[0], sources[1], sources[2]
+	if naver.Name != "네이버 D2" || naver.Count != 0 || naver.URL != "https://d2.naver.com" {
+		t.Errorf("네이버 = %+v", naver)
+	}
+	if kakao.Name != "카카오" || kakao.Count != 2 || fmt.Sprint(kakao.Categories) != "[AI Backend]" {
+		t.Errorf("카카오 = %+v", kakao)
+	}
+	if toss.Name != "토스" || toss.Count != 25 {
+		t.Errorf("토스 = %+v", toss)
+	}
+}
+
+func TestLatestRun(t *testing.T) {
+	empty := NewServer(store.NewPostStore())
+	if rec := get(t, empty, "/api/runs/latest", nil); rec.Code != http.StatusNotFound {
+		t.Errorf("실행 전 status = %d, want 404", rec.Code)
+	}
+
+	rec := get(t, newTestServer(t), "/api/runs/latest", nil)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d", rec.Code)
+	}
+	run := decode[models.CrawlRun](t, rec)
+	if run.FilterDate != "2024-01-01" || len(run.Sources) != 3 || run.Sources[2].Error != "피드 요청 실패" {
+		t.Errorf("run = %+v", run)
+	}
+}
+
+func TestETagNotModified(t *testing.T) {
+	s := newTestServer(t)
+
+	first := get(t, s, "/api/posts?source=카카오", nil)
+	etag := first.Header().Get("ETag")
+	if etag == "" || first.Header().Get("Cache-Control") != "no-cache" {
+		t.Fatalf("헤더 = %v", first.Header())
+	}
+	if again := get(t, s, "/api/posts?source=카카오", nil); again.Header().Get("ETag") != etag {
+		t.Errorf("같은 응답의 ETag가 다름: %q, %q", etag, again.Header().Get("ETag"))
+	}
+	if other := get(t, s, "/api/posts?source=토스", nil); other.Header().Get("ETag") == etag {
+		t.Error("다른 응답의 ETag가 같음")
+	}
+
+	for _, header := range []string{etag, `"other", ` + etag, "W/" + etag, "*"} {
+		rec := get(t, s, "/api/posts?source=카카오", http.Header{"If-None-Match": {header}})
+		if rec.Code != http.StatusNotModified || rec.Body.Len() != 0 {
+			t.Errorf("If-None-Match %s: status = %d, body %d바이트", header, rec.Code, rec.Body.Len())
+		}
+		if rec.Header().Get("ETag") != etag {
+			t.Errorf("If-None-Match %s: 304 응답에 ETag 없음", header)
+		}
+	}
+
+	rec := get(t, s, "/api/posts?source=카카오", http.Header{"If-None-Match": {`"stale"`}})
+	if rec.Code != http.StatusOK || rec.Body.Len() == 0 {
+		t.Errorf("일치하지 않는 ETag: status = %d", rec.Code)
+	}
+}
+
+func TestIndexETag(t *testing.T) {
+	s := newTestServer(t)
+	if rec := get(t, s, "/", nil); rec.Code != http.StatusServiceUnavailable {
+		t.Errorf("HTML 생성 전 status = %d, want 503", rec.Code)
+	}
+
+	s.SetHTML("<html>v1</html>")
+	first := get(t, s, "/index.html", nil)
+	if first.Code != http.StatusOK || first.Header().Get("Content-Type") != "text/html; charset=utf-8" {
+		t.Fatalf("status = %d, 헤더 = %v", first.Code, first.Header())
+	}
+	etag := first.Header().Get("ETag")
+	if rec := get(t, s, "/", http.Header{"If-None-Match": {etag}}); rec.Code != http.StatusNotModified {
+		t.Errorf("status = %d, want 304", rec.Code)
+	}
+
+	// HTML이 바뀌면 이전 ETag로는 304가 아님
+	s.SetHTML("<html>v2</html>")
+	if rec := get(t, s, "/", http.Header{"If-None-Match": {etag}}); rec.Code != http.StatusOK || rec.Body.String() != "<html>v2</html>" {
+		t.Errorf("갱신 후 status = %d, body = %q", rec.Code, rec.Body.String())
+	}
+}
@@ -0,0 +1,152 @@
+package snapshot
+
+import (
+	"sort"
+	"time"
+
+	"hello-go/internal/models"
+)
+
+// PostChange는 두 스냅샷 사이에서 변경된 포스트입니다.
+type PostChange struct {
+	Previous models.BlogPost `json:"previous"`
+	Current  models.BlogPost `json:"current"`
+	// Fields는 변경된 필드 이름 목록입니다. (title, summary, published_at)
+	Fields []string `json:"fields"`
+}
+
+// SourceDiff는 소스 하나의 변경 내역입니다.
+type SourceDiff struct {
+	Source   string            `json:"source"`
+	Added    []models.BlogPost `json:"added"`
+	Removed  []models.BlogPost `json:"removed"`
+	Modified []PostChange      `json:"modified"`
+}
+
+// Diff는 두 스냅샷의 소스별 변경 내역입니다.
+type Diff struct {
+	// HasPrevious는 비교할 이전 스냅샷이 있었는지 여부입니다.
+	HasPrevious bool          `json:"has_previous"`
+	PreviousRun time.Time     `json:"previous_run"`
+	CurrentRun  time.Time     `json:"current_run"`
+	Sources     []*SourceDiff `json:"sources"`
+}
+
+// Compare는 두 스냅샷을 URL 기준으로 비교하여 추가, 삭제, 변경된 포스트를 소스별로 반환합니다.
+func Compare(previous, current Snapshot) Diff {
+	diff := Diff{
+		HasPrevious: true,
+		PreviousRun: previous.Run.FinishedAt,
+		CurrentRun:  current.Run.FinishedAt,
+	}
+
+	sources := make(map[string]*SourceDiff)
+	sourceOf := func(name string) *SourceDiff {
+		if sd, ok := sources[name]; ok {
+			return sd
+		}
+		sd := &SourceDiff{Source: name}
+		sources[name] = sd
+		return sd
+	}
+
+	previousPosts := make(map[string]models.BlogPost, len(previous.Posts))
+	for _, post := range previous.Posts {
+		previousPosts[post.URL] = post
+	}
+	currentPosts := make(map[string]bool, len(current.Posts))
+
+	for _, post := range current.Posts {
+		currentPosts[post.URL] = true
+		old, ok := previousPosts[post.URL]
+		if !ok {
+			sd := sourceOf(post.Source)
+			sd.Added = append(sd.Added, post)
+			continue
+		}
+		if fields := changedFields(old, post); len(fields) > 0 {
+			sd := sourceOf(post.Source)
+			sd.Modified = append(sd.Modified, PostChange{Previous: old, Current: post, Fields: fields})
+		}
+	}
+
+	for _, post := range previous.Posts {
+		if !currentPosts[post.URL] {
+			sd := sourceOf(post.Source)
+			sd.Removed = append(sd.Removed, post)
+		}
+	}
+
+	for _, sd := range sources {
+		diff.Sources = append(diff.Sources, sd)
+	}
+	sort.Slice(diff.Sources, func(i, j int) bool {
+		return diff.Sources[i].Source < diff.Sources[j].Source
+	})
+
+	return diff
+}
+
+// changedFields는 비교 대상 필드 중 값이 달라진 필드 이름을 반환합니다.
+func changedFields(previous, current models.BlogPost) []string {
+	var fields []string
+	if previous.Title != current.Title {
+		fields = append(fields, "title")
+	}
+	if previous.Summary != current.Summary {
+		fields = append(fields, "summary")
+	}
+	if !previous.PublishedAt.Equal(current.PublishedAt) {
+		fields = append(fields, "published_at")
+	}
+	if !previous.UpdatedAt.Equal(current.UpdatedAt) {
+		fields = append(fields, "updated_at")
+	}
+	return fields
+}
+
+// Added는 모든 소스에서 추가된 포스트를 반환합니다.
+func (d Diff) Added() []models.BlogPost {
+	var posts []models.BlogPost
+	for _, sd := range d.Sources {
+		posts = append(posts, sd.Added...)
+	}
+	return posts
+}
+
+// Removed는 모든 소스에서 삭제된 포스트를 반환합니다.
+func (d Diff) Removed() []models.BlogPost {
+	var posts []models.BlogPost
+	for _, sd := range d.Sources {
+		posts = append(posts, sd.Removed...)
+	}
+	return posts
+}
+
+// Modified는 모든 소스에서 변경된 포스트를 반환합니다.
+func (d Diff) Modified() []PostChange {
+	var changes []PostChange
+	for _, sd := range d.Sources {
+		changes = append(changes, sd.Modified...)
+	}
+	return changes
+}
+
+// NewPosts는 지난 실행 이후 새로 추가된 포스트를 반환합니다. 이전 스냅샷이 없으면 비어 있습니다.
+func (d Diff) NewPosts() []models.BlogPost {
+	if !d.HasPrevious {
+		return nil
+	}
+	return d.Added()
+}
+
+// MarkNew는 비교 결과에서 추가된 포스트에 IsNew를 표시합니다.
+func MarkNew(posts []models.BlogPost, diff Diff) {
+	newURLs := make(map[string]bool)
+	for _, post := range diff.NewPosts() {
+		newURLs[post.URL] = true
+	}
+	for i := range posts {
+		posts[i].IsNew = newURLs[posts[i].URL]
+	}
+}
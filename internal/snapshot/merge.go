@@ -0,0 +1,28 @@
+package snapshot
+
+import (
+	"sort"
+
+	"hello-go/internal/models"
+)
+
+// Merge는 이전 포스트 목록에 새로 크롤링한 포스트를 URL 기준으로 덮어써 합치고 최신순으로 정렬합니다.
+func Merge(previous, current []models.BlogPost) []models.BlogPost {
+	merged := make([]models.BlogPost, 0, len(previous)+len(current))
+	seen := make(map[string]bool, len(current))
+	for _, post := range current {
+		seen[post.URL] = true
+		merged = append(merged, post)
+	}
+	for _, post := range previous {
+		if !seen[post.URL] {
+			seen[post.URL] = true
+			merged = append(merged, post)
+		}
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].PublishedAt.After(merged[j].PublishedAt)
+	})
+	return merged
+}
@@ -0,0 +1,38 @@
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"hello-go/internal/models"
+)
+
+// Snapshot은 한 번의 크롤링 결과로 얻은 최종 포스트 목록입니다.
+type Snapshot struct {
+	Run   models.CrawlRun   `json:"run"`
+	Posts []models.BlogPost `json:"posts"`
+}
+
+// Read는 JSON 파일에서 스냅샷을 읽습니다.
+func Read(path string) (Snapshot, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Snapshot{}, fmt.Errorf("스냅샷 읽기 실패: %w", err)
+	}
+
+	var snap Snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return Snapshot{}, fmt.Errorf("스냅샷 파싱 실패 (%s): %w", path, err)
+	}
+	return snap, nil
+}
+
+// Marshal은 스냅샷을 들여쓰기 된 JSON으로 인코딩합니다.
+func (s Snapshot) Marshal() ([]byte, error) {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("스냅샷 인코딩 실패: %w", err)
+	}
+	return data, nil
+}
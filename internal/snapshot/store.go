@@ -0,0 +1,267 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"hello-go/internal/models"
+	"hello-go/internal/storage"
+)
+
+// keyPrefix는 스냅샷 객체 키의 접두사입니다.
+const keyPrefix = "snapshots/"
+
+// keyLayout은 스냅샷 키의 시각 형식입니다. 같은 초에 끝난 실행이 서로 덮어쓰지 않도록 나노초까지 고정 폭으로 기록하여
+// 사전순이 시간순과 같습니다.
+const keyLayout = "20060102T150405.000000000Z"
+
+// DefaultKeep은 기본으로 보관하는 스냅샷 수입니다.
+const DefaultKeep = 100
+
+// Retention은 스냅샷 보관 정책입니다. 0인 값은 제한하지 않으며, 가장 최근 스냅샷은 항상 보관합니다.
+type Retention struct {
+	// Keep은 최근 것부터 보관할 스냅샷 수입니다.
+	Keep int
+	// MaxAge는 스냅샷을 보관하는 최대 기간입니다. 실행 종료 시각(키의 시각)을 기준으로 합니다.
+	MaxAge time.Duration
+}
+
+// Store는 실행마다 타임스탬프가 붙은 스냅샷을 저장소에 보관합니다.
+type Store struct {
+	storage   storage.Storage
+	retention Retention
+}
+
+// NewStore는 새로운 Store 인스턴스를 생성합니다. 보관 정책은 최근 DefaultKeep개입니다.
+func NewStore(s storage.Storage) *Store {
+	return &Store{storage: s, retention: Retention{Keep: DefaultKeep}}
+}
+
+// SetRetention은 Commit 후 정리할 보관 정책을 설정합니다.
+func (s *Store) SetRetention(r Retention) {
+	s.retention = r
+}
+
+// Save는 스냅샷을 실행 종료 시각으로 된 키에 저장하고 키를 반환합니다.
+// 같은 키의 스냅샷이 이미 있으면 시각을 1나노초씩 늘려 빈 키를 사용합니다.
+func (s *Store) Save(snap Snapshot) (string, error) {
+	finishedAt := snap.Run.FinishedAt
+	if finishedAt.IsZero() {
+		finishedAt = time.Now()
+	}
+	key := snapshotKey(finishedAt)
+	for {
+		_, err := s.storage.Read(key)
+		if errors.Is(err, storage.ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return "", fmt.Errorf("스냅샷 키 확인 실패: %w", err)
+		}
+		finishedAt = finishedAt.Add(time.Nanosecond)
+		key = snapshotKey(finishedAt)
+	}
+
+	data, err := snap.Marshal()
+	if err != nil {
+		return "", err
+	}
+	if err := s.storage.Write(key, data); err != nil {
+		return "", fmt.Errorf("스냅샷 저장 실패: %w", err)
+	}
+	return key, nil
+}
+
+// snapshotKey는 실행 종료 시각의 스냅샷 키를 반환합니다.
+func snapshotKey(t time.Time) string {
+	return keyPrefix + t.UTC().Format(keyLayout) + ".json"
+}
+
+// keyTime은 스냅샷 키의 시각을 반환합니다.
+func keyTime(key string) (time.Time, bool) {
+	name, ok := strings.CutPrefix(key, keyPrefix)
+	if !ok {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(keyLayout, strings.TrimSuffix(name, ".json"))
+	return t, err == nil
+}
+
+// Keys는 저장된 스냅샷 키를 오래된 순으로 반환합니다.
+func (s *Store) Keys() ([]string, error) {
+	return s.storage.List(keyPrefix)
+}
+
+// Load는 키에 해당하는 스냅샷을 읽습니다.
+func (s *Store) Load(key string) (Snapshot, error) {
+	data, err := s.storage.Read(key)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	var snap Snapshot
+	if err := json.Unmarshal(data, &snap); err != nil {
+		return Snapshot{}, fmt.Errorf("스냅샷 파싱 실패 (%s): %w", key, err)
+	}
+	return snap, nil
+}
+
+// Latest는 가장 최근 스냅샷을 반환합니다. 스냅샷이 없으면 false를 반환합니다.
+func (s *Store) Latest() (Snapshot, bool, error) {
+	keys, err := s.Keys()
+	if err != nil {
+		return Snapshot{}, false, err
+	}
+	if len(keys) == 0 {
+		return Snapshot{}, false, nil
+	}
+
+	snap, err := s.Load(keys[len(keys)-1])
+	if err != nil {
+		return Snapshot{}, false, err
+	}
+	return snap, true, nil
+}
+
+// Record는 직전 스냅샷과 비교하여 새 포스트에 IsNew를 표시하고, 스냅샷을 저장한 뒤 비교 결과를 반환합니다.
+func (s *Store) Record(snap *Snapshot) (Diff, error) {
+	diff, err := s.Mark(snap)
+	if err != nil {
+		return Diff{}, err
+	}
+	return diff, s.Commit(*snap, diff)
+}
+
+// Mark는 직전 스냅샷과 비교하여 새 포스트에 IsNew를 표시하고 비교 결과를 반환합니다. 스냅샷은 저장하지 않으므로,
+// 결과물을 모두 쓴 뒤 Commit으로 저장합니다.
+func (s *Store) Mark(snap *Snapshot) (Diff, error) {
+	previous, ok, err := s.Latest()
+	if err != nil {
+		return Diff{}, err
+	}
+
+	if !ok {
+		log.Println("📸 이전 스냅샷이 없어 새 포스트 표시를 건너뜁니다.")
+		diff := Compare(Snapshot{}, *snap)
+		diff.HasPrevious = false
+		return diff, nil
+	}
+	diff := Compare(previous, *snap)
+	MarkNew(snap.Posts, diff)
+	return diff, nil
+}
+
+// Commit은 Mark로 표시한 스냅샷을 저장하고 보관 정책을 벗어난 스냅샷을 정리합니다.
+// 정리에 실패해도 저장한 스냅샷은 유효하므로 기록만 남기고 다음 실행에서 다시 정리합니다.
+func (s *Store) Commit(snap Snapshot, diff Diff) error {
+	key, err := s.Save(snap)
+	if err != nil {
+		return err
+	}
+	log.Printf("📸 스냅샷 저장: %s (추가 %d개, 삭제 %d개, 변경 %d개)",
+		key, len(diff.Added()), len(diff.Removed()), len(diff.Modified()))
+
+	pruned, err := s.Prune(s.retention, time.Now())
+	if err != nil {
+		log.Printf("스냅샷 정리 실패: %v", err)
+	} else if len(pruned) > 0 {
+		log.Printf("🧹 오래된 스냅샷 %d개 삭제", len(pruned))
+	}
+	return nil
+}
+
+// Prune은 보관 정책을 벗어난 스냅샷을 삭제하고 삭제한 키를 반환합니다.
+// 가장 최근 스냅샷과 시각을 알 수 없는 키는 삭제하지 않습니다.
+func (s *Store) Prune(r Retention, now time.Time) ([]string, error) {
+	keys, err := s.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	type saved struct {
+		key string
+		at  time.Time
+	}
+	var snapshots []saved
+	for _, key := range keys {
+		if at, ok := keyTime(key); ok {
+			snapshots = append(snapshots, saved{key, at})
+		}
+	}
+
+	var pruned []string
+	for i, snap := range snapshots[:max(len(snapshots)-1, 0)] {
+		excess := r.Keep > 0 && i < len(snapshots)-r.Keep
+		expired := r.MaxAge > 0 && now.Sub(snap.at) > r.MaxAge
+		if !excess && !expired {
+			continue
+		}
+		if err := s.storage.Delete(snap.key); err != nil {
+			return pruned, fmt.Errorf("스냅샷 삭제 실패: %w", err)
+		}
+		pruned = append(pruned, snap.key)
+	}
+	return pruned, nil
+}
+
+// LatestSince는 수집 기준일(Run.FilterDate)이 filterDate인 스냅샷 중 가장 최근 것을 반환합니다.
+// 기준일이 다른 스냅샷은 포스트 수를 비교할 수 없으므로 건너뛰며, 없으면 false를 반환합니다.
+// 최근 것부터 실행 정보만 디코딩하여 확인하고, 기준일이 같은 스냅샷을 찾으면 그 스냅샷만 전체를 읽습니다.
+func (s *Store) LatestSince(filterDate string) (Snapshot, bool, error) {
+	keys, err := s.Keys()
+	if err != nil {
+		return Snapshot{}, false, err
+	}
+	for i := len(keys) - 1; i >= 0; i-- {
+		run, err := s.loadRun(keys[i])
+		if err != nil {
+			return Snapshot{}, false, err
+		}
+		if run.FilterDate != filterDate {
+			continue
+		}
+		snap, err := s.Load(keys[i])
+		if err != nil {
+			return Snapshot{}, false, err
+		}
+		return snap, true, nil
+	}
+	return Snapshot{}, false, nil
+}
+
+// loadRun은 키에 해당하는 스냅샷의 실행 정보만 디코딩합니다. 스냅샷은 실행 정보를 포스트 목록보다 먼저 쓰므로
+// 실행 정보를 읽으면 나머지는 파싱하지 않고 멈춥니다.
+func (s *Store) loadRun(key string) (models.CrawlRun, error) {
+	data, err := s.storage.Read(key)
+	if err != nil {
+		return models.CrawlRun{}, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return models.CrawlRun{}, fmt.Errorf("스냅샷 파싱 실패 (%s): 객체가 아님", key)
+	}
+	for dec.More() {
+		name, err := dec.Token()
+		if err != nil {
+			return models.CrawlRun{}, fmt.Errorf("스냅샷 파싱 실패 (%s): %w", key, err)
+		}
+		if name == "run" {
+			var run models.CrawlRun
+			if err := dec.Decode(&run); err != nil {
+				return models.CrawlRun{}, fmt.Errorf("스냅샷 파싱 실패 (%s): %w", key, err)
+			}
+			return run, nil
+		}
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return models.CrawlRun{}, fmt.Errorf("스냅샷 파싱 실패 (%s): %w", key, err)
+		}
+	}
+	return models.CrawlRun{}, nil
+}
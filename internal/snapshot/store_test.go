@@ -0,0 +1,183 @@
+package snapshot
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"hello-go/internal/models"
+	"hello-go/internal/storage"
+)
+
+func TestSaveSameSecondKeepsBoth(t *testing.T) {
+	store := NewStore(storage.NewFileStorage(t.TempDir()))
+	finishedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	first, err := store.Save(Snapshot{Run: models.CrawlRun{FinishedAt: finishedAt}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := store.Save(Snapshot{Run: models.CrawlRun{FinishedAt: finishedAt}, Posts: []models.BlogPost{{URL: "u"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first == second {
+		t.Fatalf("같은 키에 저장됨: %s", first)
+	}
+
+	latest, ok, err := store.Latest()
+	if err != nil || !ok {
+		t.Fatalf("Latest() = %v, %v", ok, err)
+	}
+	if len(latest.Posts) != 1 {
+		t.Errorf("Latest()가 나중에 저장한 스냅샷이 아님: 포스트 %d개", len(latest.Posts))
+	}
+}
+
+func TestLatestSinceSkipsOtherWindows(t *testing.T) {
+	store := NewStore(storage.NewFileStorage(t.TempDir()))
+	base := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	for i, filterDate := range []string{"2025-01-01", "2025-01-01", "2025-02-15"} {
+		snap := Snapshot{Run: models.CrawlRun{FinishedAt: base.Add(time.Duration(i) * time.Hour), FilterDate: filterDate}}
+		snap.Posts = make([]models.BlogPost, i+1)
+		if _, err := store.Save(snap); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	snap, ok, err := store.LatestSince("2025-01-01")
+	if err != nil || !ok {
+		t.Fatalf("LatestSince() = %v, %v", ok, err)
+	}
+	if len(snap.Posts) != 2 {
+		t.Errorf("기준일이 같은 가장 최근 스냅샷이 아님: 포스트 %d개", len(snap.Posts))
+	}
+	if _, ok, _ := store.LatestSince("2024-12-01"); ok {
+		t.Error("기준일이 같은 스냅샷이 없는데 true 반환")
+	}
+}
+
+// saveAt은 finishedAt에 끝난 실행의 스냅샷을 저장하고 키를 반환합니다.
+func saveAt(t *testing.T, store *Store, finishedAt time.Time) string {
+	t.Helper()
+	key, err := store.Save(Snapshot{Run: models.CrawlRun{FinishedAt: finishedAt, FilterDate: "2025-01-01"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+func TestPrune(t *testing.T) {
+	now := time.Date(2025, 3, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		retention Retention
+		ages      []int // 각 스냅샷의 나이(일), 오래된 순
+		kept      int
+	}{
+		{"keep", Retention{Keep: 2}, []int{5, 4, 3, 2, 1}, 2},
+		{"max age", Retention{MaxAge: 72 * time.Hour}, []int{5, 4, 3, 2, 1}, 3},
+		{"keep and max age", Retention{Keep: 4, MaxAge: 48 * time.Hour}, []int{5, 4, 3, 2, 1}, 2},
+		// 가장 최근 스냅샷은 기간이 지나도 보관
+		{"latest kept", Retention{MaxAge: time.Hour}, []int{5, 4}, 1},
+		{"unlimited", Retention{}, []int{5, 4, 3}, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := NewStore(storage.NewFileStorage(t.TempDir()))
+			var keys []string
+			for _, age := range tt.ages {
+				keys = append(keys, saveAt(t, store, now.AddDate(0, 0, -age)))
+			}
+
+			pruned, err := store.Prune(tt.retention, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			remaining, err := store.Keys()
+			if err != nil {
+				t.Fatal(err)
+			}
+			// 오래된 것부터 삭제하여 최근 kept개가 남음
+			if fmt.Sprint(remaining) != fmt.Sprint(keys[len(keys)-tt.kept:]) || len(pruned) != len(keys)-tt.kept {
+				t.Errorf("남은 스냅샷 %v, 삭제 %v", remaining, pruned)
+			}
+		})
+	}
+}
+
+func TestCommitPrunesWithRetention(t *testing.T) {
+	site := storage.NewFileStorage(t.TempDir())
+	store := NewStore(site)
+	store.SetRetention(Retention{Keep: 3})
+	base := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+	saveAt(t, store, base.Add(-time.Hour))
+	saveAt(t, store, base)
+	saveAt(t, store, base.Add(time.Hour))
+	// 스냅샷 키가 아닌 객체는 세지도 삭제하지도 않음
+	if err := site.Write(keyPrefix+"0-manual.json", []byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+
+	snap := Snapshot{Run: models.CrawlRun{FinishedAt: base.Add(2 * time.Hour)}}
+	diff, err := store.Mark(&snap)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Commit(snap, diff); err != nil {
+		t.Fatal(err)
+	}
+
+	keys, err := store.Keys()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{keyPrefix + "0-manual.json", snapshotKey(base), snapshotKey(base.Add(time.Hour)), snapshotKey(base.Add(2 * time.Hour))}
+	if fmt.Sprint(keys) != fmt.Sprint(want) {
+		t.Errorf("keys = %v, want %v", keys, want)
+	}
+}
+
+// countingStorage는 읽은 키를 기록합니다.
+type countingStorage struct {
+	storage.Storage
+	reads []string
+}
+
+func (c *countingStorage) Read(key string) ([]byte, error) {
+	c.reads = append(c.reads, key)
+	return c.Storage.Read(key)
+}
+
+func TestLatestSinceStopsAtMatch(t *testing.T) {
+	site := &countingStorage{Storage: storage.NewFileStorage(t.TempDir())}
+	store := NewStore(site)
+	base := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	// 찾는 스냅샷보다 오래된 스냅샷은 읽지 않음
+	oldest := snapshotKey(base)
+	if err := site.Write(oldest, []byte("깨진 스냅샷")); err != nil {
+		t.Fatal(err)
+	}
+	match, err := store.Save(Snapshot{
+		Run:   models.CrawlRun{FinishedAt: base.Add(time.Hour), FilterDate: "2025-01-01"},
+		Posts: []models.BlogPost{{URL: "u"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 기준일이 다른 최근 스냅샷은 실행 정보만 디코딩하므로 포스트 목록이 깨져 있어도 건너뜀
+	newest := snapshotKey(base.Add(2 * time.Hour))
+	if err := site.Write(newest, []byte(`{"run": {"filter_date": "2025-03-01"}, "posts": [깨진`)); err != nil {
+		t.Fatal(err)
+	}
+	site.reads = nil
+
+	snap, ok, err := store.LatestSince("2025-01-01")
+	if err != nil || !ok || len(snap.Posts) != 1 {
+		t.Fatalf("LatestSince() = %+v, %v, %v", snap, ok, err)
+	}
+	if fmt.Sprint(site.reads) != fmt.Sprint([]string{newest, match, match}) {
+		t.Errorf("읽은 키 = %v", site.reads)
+	}
+}
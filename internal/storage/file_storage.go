@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FileStorage는 로컬 디렉터리를 저장소로 사용합니다.
+type FileStorage struct {
+	root string
+}
+
+// NewFileStorage는 root 디렉터리를 사용하는 FileStorage 인스턴스를 생성합니다.
+func NewFileStorage(root string) *FileStorage {
+	return &FileStorage{root: root}
+}
+
+// Read는 키에 해당하는 파일을 읽습니다.
+func (s *FileStorage) Read(key string) ([]byte, error) {
+	data, err := os.ReadFile(s.path(key))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("%s: %w", key, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("파일 읽기 실패 (%s): %w", key, err)
+	}
+	return data, nil
+}
+
+// Write는 키에 해당하는 파일을 씁니다. 필요한 디렉터리는 자동으로 생성합니다.
+func (s *FileStorage) Write(key string, data []byte) error {
+	path := s.path(key)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("디렉터리 생성 실패 (%s): %w", key, err)
+	}
+
+	// 쓰는 도중 실패해도 기존 파일이 깨지지 않도록 임시 파일에 쓴 뒤 교체
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("파일 쓰기 실패 (%s): %w", key, err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("파일 교체 실패 (%s): %w", key, err)
+	}
+	return nil
+}
+
+// List는 prefix로 시작하는 키 목록을 사전순으로 반환합니다. prefix의 디렉터리 부분 아래만 탐색합니다.
+func (s *FileStorage) List(prefix string) ([]string, error) {
+	dir := s.root
+	if i := strings.LastIndex(prefix, "/"); i >= 0 {
+		dir = s.path(prefix[:i])
+	}
+
+	var keys []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return filepath.SkipDir
+			}
+			return err
+		}
+		if d.IsDir() || strings.HasSuffix(path, ".tmp") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("파일 목록 조회 실패: %w", err)
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// Delete는 키에 해당하는 파일을 삭제합니다.
+func (s *FileStorage) Delete(key string) error {
+	if err := os.Remove(s.path(key)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("파일 삭제 실패 (%s): %w", key, err)
+	}
+	return nil
+}
+
+func (s *FileStorage) path(key string) string {
+	return filepath.Join(s.root, filepath.FromSlash(key))
+}
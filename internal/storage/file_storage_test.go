@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFileStorageListPrefix(t *testing.T) {
+	s := NewFileStorage(t.TempDir())
+	for _, key := range []string{"snapshots/b.json", "snapshots/a.json", "snapshots-old/c.json", "partials/toss.json", "index.html"} {
+		if err := s.Write(key, []byte("{}")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		prefix string
+		want   []string
+	}{
+		{"snapshots/", []string{"snapshots/a.json", "snapshots/b.json"}},
+		{"snapshots/a", []string{"snapshots/a.json"}},
+		{"snap", []string{"snapshots-old/c.json", "snapshots/a.json", "snapshots/b.json"}},
+		{"missing/", nil},
+	}
+	for _, tt := range tests {
+		got, err := s.List(tt.prefix)
+		if err != nil {
+			t.Fatalf("List(%q): %v", tt.prefix, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("List(%q) = %v, want %v", tt.prefix, got, tt.want)
+		}
+	}
+}
+
+func TestFileStorageDelete(t *testing.T) {
+	s := NewFileStorage(t.TempDir())
+	if err := s.Write("snapshots/a.json", []byte("{}")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Delete("snapshots/a.json"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Read("snapshots/a.json"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("삭제 후 Read: err = %v, want ErrNotFound", err)
+	}
+	// 없는 키를 삭제해도 오류가 아님
+	if err := s.Delete("snapshots/a.json"); err != nil {
+		t.Errorf("없는 키 삭제: %v", err)
+	}
+}
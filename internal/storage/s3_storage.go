@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"path"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+// S3Storage는 S3 버킷을 저장소로 사용합니다.
+type S3Storage struct {
+	client *s3.Client
+	bucket string
+}
+
+// NewS3Storage는 새로운 S3Storage 인스턴스를 생성합니다.
+func NewS3Storage(client *s3.Client, bucket string) *S3Storage {
+	return &S3Storage{client: client, bucket: bucket}
+}
+
+// Read는 키에 해당하는 객체를 읽습니다.
+func (s *S3Storage) Read(key string) ([]byte, error) {
+	out, err := s.client.GetObject(context.TODO(), &s3.GetObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		var noSuchKey *types.NoSuchKey
+		if errors.As(err, &noSuchKey) {
+			return nil, fmt.Errorf("s3://%s/%s: %w", s.bucket, key, ErrNotFound)
+		}
+		return nil, fmt.Errorf("S3 객체 읽기 실패 (%s): %w", key, err)
+	}
+	defer out.Body.Close()
+
+	data, err := io.ReadAll(out.Body)
+	if err != nil {
+		return nil, fmt.Errorf("S3 객체 본문 읽기 실패 (%s): %w", key, err)
+	}
+	return data, nil
+}
+
+// Write는 키에 객체를 업로드합니다. Content-Type은 확장자로 결정합니다.
+func (s *S3Storage) Write(key string, data []byte) error {
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	_, err := s.client.PutObject(context.TODO(), &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(data),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		return fmt.Errorf("S3 업로드 실패 (%s): %w", key, err)
+	}
+	return nil
+}
+
+// List는 prefix로 시작하는 키 목록을 사전순으로 반환합니다.
+func (s *S3Storage) List(prefix string) ([]string, error) {
+	var keys []string
+	paginator := s3.NewListObjectsV2Paginator(s.client, &s3.ListObjectsV2Input{
+		Bucket: aws.String(s.bucket),
+		Prefix: aws.String(prefix),
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(context.TODO())
+		if err != nil {
+			return nil, fmt.Errorf("S3 객체 목록 조회 실패: %w", err)
+		}
+		for _, object := range page.Contents {
+			keys = append(keys, aws.ToString(object.Key))
+		}
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// Delete는 키에 해당하는 객체를 삭제합니다. S3는 없는 키의 삭제도 성공으로 응답합니다.
+func (s *S3Storage) Delete(key string) error {
+	_, err := s.client.DeleteObject(context.TODO(), &s3.DeleteObjectInput{
+		Bucket: aws.String(s.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return fmt.Errorf("S3 객체 삭제 실패 (%s): %w", key, err)
+	}
+	return nil
+}
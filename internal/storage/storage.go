@@ -0,0 +1,18 @@
+package storage
+
+import (
+	"errors"
+)
+
+// ErrNotFound는 요청한 키의 객체가 없을 때 반환됩니다.
+var ErrNotFound = errors.New("객체를 찾을 수 없습니다")
+
+// Storage는 키 단위로 객체를 읽고 쓰는 저장소 인터페이스입니다.
+type Storage interface {
+	Read(key string) ([]byte, error)
+	Write(key string, data []byte) error
+	// List는 prefix로 시작하는 키 목록을 사전순으로 반환합니다.
+	List(prefix string) ([]string, error)
+	// Delete는 키의 객체를 삭제합니다. 없는 키를 삭제해도 오류가 아닙니다.
+	Delete(key string) error
+}
@@ -0,0 +1,205 @@
+package store
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"hello-go/internal/models"
+)
+
+// DefaultPageSize는 페이지당 기본 포스트 수입니다.
+const DefaultPageSize = 20
+
+// PostQuery는 포스트 조회 조건을 담는 구조체입니다.
+type PostQuery struct {
+	Source   string
+	Category string
+	Since    time.Time
+	Q        string
+	Page     int
+	PageSize int
+}
+
+// PostPage는 페이지 단위로 조회된 포스트 목록입니다.
+type PostPage struct {
+	Posts      []models.BlogPost `json:"posts"`
+	Page       int               `json:"page"`
+	PageSize   int               `json:"page_size"`
+	Total      int               `json:"total"`
+	TotalPages int               `json:"total_pages"`
+}
+
+// SourceSummary는 소스별 포스트 요약 정보입니다.
+type SourceSummary struct {
+	Name       string   `json:"name"`
+	URL        string   `json:"url"`
+	Count      int      `json:"count"`
+	Categories []string `json:"categories"`
+}
+
+// PostStore는 마지막 크롤링 결과를 메모리에 보관하는 저장소입니다.
+type PostStore struct {
+	mu    sync.RWMutex
+	posts []models.BlogPost
+	run   *models.CrawlRun
+}
+
+// NewPostStore는 새로운 PostStore 인스턴스를 생성합니다.
+func NewPostStore() *PostStore {
+	return &PostStore{}
+}
+
+// Replace는 저장된 포스트와 실행 정보를 새 크롤링 결과로 교체합니다.
+func (s *PostStore) Replace(posts []models.BlogPost, run models.CrawlRun) {
+	// 최신순으로 정렬된 복사본을 보관
+	sorted := make([]models.BlogPost, len(posts))
+	copy(sorted, posts)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].PublishedAt.After(sorted[j].PublishedAt)
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.posts = sorted
+	s.run = &run
+}
+
+// Upsert는 포스트 하나를 URL 기준으로 추가하거나 교체합니다. 크롤링 중 파이프라인이 찾은 포스트를 바로 보여줄 때 사용합니다.
+// 최신순 정렬을 유지하며, 실행 정보는 바꾸지 않습니다.
+func (s *PostStore) Upsert(post models.BlogPost) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, existing := range s.posts {
+		if existing.URL == post.URL {
+			s.posts = slices.Delete(s.posts, i, i+1)
+			break
+		}
+	}
+	// 같은 발행 시각의 포스트 뒤에 삽입
+	i := sort.Search(len(s.posts), func(i int) bool {
+		return s.posts[i].PublishedAt.Before(post.PublishedAt)
+	})
+	s.posts = slices.Insert(s.posts, i, post)
+}
+
+// Checkpoint는 현재 포스트와 실행 정보를 보관하고, 그 상태로 되돌리는 함수를 반환합니다.
+// Upsert로 추가한 포스트를 크롤링이 실패했을 때 걷어낼 때 사용합니다.
+func (s *PostStore) Checkpoint() (restore func()) {
+	s.mu.RLock()
+	posts := slices.Clone(s.posts)
+	run := s.run
+	s.mu.RUnlock()
+
+	return func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.posts = posts
+		s.run = run
+	}
+}
+
+// Query는 조건에 맞는 포스트를 페이지 단위로 반환합니다.
+func (s *PostStore) Query(q PostQuery) PostPage {
+	if q.Page < 1 {
+		q.Page = 1
+	}
+	if q.PageSize < 1 {
+		q.PageSize = DefaultPageSize
+	}
+	keyword := strings.ToLower(strings.TrimSpace(q.Q))
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	matched := []models.BlogPost{}
+	for _, post := range s.posts {
+		if q.Source != "" && post.Source != q.Source {
+			continue
+		}
+		if q.Category != "" && post.Category != q.Category {
+			continue
+		}
+		if !q.Since.IsZero() && post.PublishedAt.Before(q.Since) {
+			continue
+		}
+		if keyword != "" &&
+			!strings.Contains(strings.ToLower(post.Title), keyword) &&
+			!strings.Contains(strings.ToLower(post.Summary), keyword) {
+			continue
+		}
+		matched = append(matched, post)
+	}
+
+	page := PostPage{
+		Page:       q.Page,
+		PageSize:   q.PageSize,
+		Total:      len(matched),
+		TotalPages: (len(matched) + q.PageSize - 1) / q.PageSize,
+		Posts:      []models.BlogPost{},
+	}
+
+	start := (q.Page - 1) * q.PageSize
+	if start < len(matched) {
+		end := min(start+q.PageSize, len(matched))
+		page.Posts = matched[start:end]
+	}
+
+	return page
+}
+
+// Sources는 소스별 포스트 수와 카테고리 목록을 반환합니다.
+func (s *PostStore) Sources() []SourceSummary {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	summaries := make(map[string]*SourceSummary)
+	categories := make(map[string]map[string]bool)
+
+	// 포스트가 없는 소스도 보이도록 실행 정보에서 먼저 채움
+	if s.run != nil {
+		for _, source := range s.run.Sources {
+			summaries[source.Name] = &SourceSummary{Name: source.Name, URL: source.URL}
+			categories[source.Name] = make(map[string]bool)
+		}
+	}
+
+	for _, post := range s.posts {
+		summary, ok := summaries[post.Source]
+		if !ok {
+			summary = &SourceSummary{Name: post.Source}
+			summaries[post.Source] = summary
+			categories[post.Source] = make(map[string]bool)
+		}
+		summary.Count++
+		categories[post.Source][post.Category] = true
+	}
+
+	result := make([]SourceSummary, 0, len(summaries))
+	for name, summary := range summaries {
+		summary.Categories = []string{}
+		for category := range categories[name] {
+			summary.Categories = append(summary.Categories, category)
+		}
+		sort.Strings(summary.Categories)
+		result = append(result, *summary)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result
+}
+
+// LatestRun은 마지막 크롤링 실행 정보를 반환합니다.
+func (s *PostStore) LatestRun() (models.CrawlRun, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.run == nil {
+		return models.CrawlRun{}, false
+	}
+	return *s.run, true
+}
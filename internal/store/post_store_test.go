@@ -0,0 +1,55 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"hello-go/internal/models"
+)
+
+func TestUpsertKeepsNewestFirst(t *testing.T) {
+	day := func(n int) time.Time { return time.Date(2025, 3, n, 0, 0, 0, 0, time.UTC) }
+	s := NewPostStore()
+	s.Replace([]models.BlogPost{
+		{URL: "https://a.example.com/1", Title: "1일", PublishedAt: day(1)},
+		{URL: "https://a.example.com/3", Title: "3일", PublishedAt: day(3)},
+	}, models.CrawlRun{})
+
+	s.Upsert(models.BlogPost{URL: "https://a.example.com/2", Title: "2일", PublishedAt: day(2)})
+	s.Upsert(models.BlogPost{URL: "https://a.example.com/4", Title: "4일", PublishedAt: day(4)})
+	// 같은 URL은 교체
+	s.Upsert(models.BlogPost{URL: "https://a.example.com/1", Title: "1일 (수정)", PublishedAt: day(1)})
+
+	page := s.Query(PostQuery{})
+	var titles []string
+	for _, post := range page.Posts {
+		titles = append(titles, post.Title)
+	}
+	want := []string{"4일", "3일", "2일", "1일 (수정)"}
+	if len(titles) != len(want) {
+		t.Fatalf("titles = %v, want %v", titles, want)
+	}
+	for i := range want {
+		if titles[i] != want[i] {
+			t.Fatalf("titles = %v, want %v", titles, want)
+		}
+	}
+}
+
+func TestCheckpointRestoresUpsertedPosts(t *testing.T) {
+	s := NewPostStore()
+	s.Replace([]models.BlogPost{{URL: "https://a.example.com/1", Title: "기존"}}, models.CrawlRun{FilterDate: "2025-01-01"})
+
+	restore := s.Checkpoint()
+	s.Upsert(models.BlogPost{URL: "https://a.example.com/1", Title: "수정"})
+	s.Upsert(models.BlogPost{URL: "https://a.example.com/2", Title: "추가"})
+	restore()
+
+	page := s.Query(PostQuery{})
+	if page.Total != 1 || page.Posts[0].Title != "기존" {
+		t.Errorf("되돌린 포스트 = %+v", page.Posts)
+	}
+	if run, ok := s.LatestRun(); !ok || run.FilterDate != "2025-01-01" {
+		t.Errorf("LatestRun() = %+v, %v", run, ok)
+	}
+}